@@ -0,0 +1,3 @@
+data "msr_crons" "gc" {
+  action = "onlinegc"
+}
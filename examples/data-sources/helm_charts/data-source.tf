@@ -0,0 +1,4 @@
+data "msr_helm_charts" "example" {
+  org_name  = "example"
+  repo_name = "charts"
+}
@@ -0,0 +1,5 @@
+data "msr_jobs" "failed_gc" {
+  action = "onlinegc"
+  status = "errors"
+  limit  = 10
+}
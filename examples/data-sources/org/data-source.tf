@@ -0,0 +1,3 @@
+data "msr_org" "example" {
+  name = "example"
+}
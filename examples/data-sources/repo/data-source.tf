@@ -0,0 +1,4 @@
+data "msr_repo" "example" {
+  namespace = "example"
+  name      = "app"
+}
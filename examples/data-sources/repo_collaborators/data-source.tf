@@ -0,0 +1,4 @@
+data "msr_repo_collaborators" "example" {
+  namespace = "someone"
+  repo_name = "example"
+}
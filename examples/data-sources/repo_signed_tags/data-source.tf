@@ -0,0 +1,5 @@
+data "msr_repo_signed_tags" "example" {
+  org_name    = "example"
+  repo_name   = "example"
+  signed_only = true
+}
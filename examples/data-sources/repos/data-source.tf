@@ -0,0 +1,5 @@
+data "msr_repos" "example" {
+  namespace  = "example"
+  visibility = "private"
+  name_regex = "^app-"
+}
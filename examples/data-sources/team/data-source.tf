@@ -0,0 +1,4 @@
+data "msr_team" "example" {
+  org  = "example"
+  name = "developers"
+}
@@ -0,0 +1,12 @@
+data "msr_teams" "example" {
+  org             = "example"
+  include_members = true
+}
+
+resource "msr_namespace_team_access" "example" {
+  for_each = { for t in data.msr_teams.example.teams : t.name => t }
+
+  org_name     = "example"
+  team_name    = each.key
+  access_level = "read-only"
+}
@@ -0,0 +1,3 @@
+data "msr_user" "example" {
+  name = "someone"
+}
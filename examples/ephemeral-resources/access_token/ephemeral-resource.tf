@@ -0,0 +1,4 @@
+ephemeral "msr_access_token" "example" {
+  username    = "example"
+  description = "short-lived token for the example pipeline"
+}
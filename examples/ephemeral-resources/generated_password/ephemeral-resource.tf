@@ -0,0 +1,10 @@
+ephemeral "msr_generated_password" "example" {
+  length  = 16
+  special = false
+}
+
+resource "msr_user" "example" {
+  name                = "example"
+  password_wo         = ephemeral.msr_generated_password.example.result
+  password_wo_version = 1
+}
@@ -0,0 +1,4 @@
+resource "msr_access_token" "example" {
+  username    = "example"
+  description = "long-lived token for the example pipeline"
+}
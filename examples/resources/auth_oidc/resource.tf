@@ -0,0 +1,8 @@
+resource "msr_auth_oidc" "example" {
+  issuer_url     = "https://idp.example.com"
+  client_id      = "msr"
+  client_secret  = "example"
+  scopes         = ["email", "groups"]
+  username_claim = "email"
+  groups_claim   = "groups"
+}
@@ -0,0 +1,11 @@
+resource "msr_auth_saml" "example" {
+  idp_metadata_url = "https://idp.example.com/saml/metadata"
+  sp_host          = "msr.example.com"
+
+  mapping {
+    attribute = "groups"
+    value     = "developers"
+    org       = "example"
+    team      = "developers"
+  }
+}
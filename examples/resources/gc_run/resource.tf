@@ -0,0 +1,6 @@
+resource "msr_gc_run" "example" {
+  duration = "30m"
+  triggers = {
+    release = "example"
+  }
+}
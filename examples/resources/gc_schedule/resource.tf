@@ -0,0 +1,4 @@
+resource "msr_gc_schedule" "example" {
+  schedule = "0 0 1 * * 6"
+  duration = "30m"
+}
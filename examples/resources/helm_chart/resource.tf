@@ -0,0 +1,6 @@
+resource "msr_helm_chart" "example" {
+  org_name    = "example"
+  repo_name   = "charts"
+  path        = "${path.module}/mychart-0.1.0.tgz"
+  source_hash = filesha256("${path.module}/mychart-0.1.0.tgz")
+}
@@ -0,0 +1,26 @@
+resource "msr_ldap_settings" "example" {
+  server_url      = "ldaps://ldap.example.com"
+  reader_dn       = "cn=reader,dc=example,dc=com"
+  reader_password = "example"
+  sync_schedule   = "@hourly"
+
+  user_search {
+    base_dn        = "ou=people,dc=example,dc=com"
+    username_attr  = "sAMAccountName"
+    full_name_attr = "cn"
+    scope_subtree  = true
+  }
+}
+
+resource "msr_team" "example" {
+  name   = "developers"
+  org_id = "example"
+
+  ldap_sync {
+    sync_mode         = "group"
+    group_dn          = "cn=developers,ou=groups,dc=example,dc=com"
+    group_member_attr = "member"
+  }
+
+  depends_on = [msr_ldap_settings.example]
+}
@@ -0,0 +1,5 @@
+resource "msr_ldap_sync_run" "example" {
+  triggers = {
+    team = msr_team.example.id
+  }
+}
@@ -0,0 +1,5 @@
+resource "msr_namespace_team_access" "example" {
+  org_name     = "example"
+  team_name    = "developers"
+  access_level = "read-write"
+}
@@ -0,0 +1,12 @@
+resource "msr_repo_signing_policy" "example" {
+  org_name  = "example"
+  repo_name = "example"
+
+  promotion {
+    teams = ["example/release"]
+  }
+
+  deployment {
+    users = ["ci"]
+  }
+}
@@ -0,0 +1,6 @@
+resource "msr_repo_user_access" "example" {
+  namespace    = "someone"
+  repo_name    = "example"
+  username     = "ci"
+  access_level = "read-write"
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UpdateAccessToken struct.
+type UpdateAccessToken struct {
+	TokenLabel string `json:"tokenLabel"`
+}
+
+// ResponseAccessToken struct.
+// Token is the secret, MSR only returns it when the token is created.
+type ResponseAccessToken struct {
+	HashedToken  string `json:"hashedToken"`
+	TokenLabel   string `json:"tokenLabel"`
+	Token        string `json:"token,omitempty"`
+	IsActive     bool   `json:"isActive"`
+	CreationTime string `json:"creationTime"`
+	LastUsed     string `json:"lastUsed"`
+	GeneratedBy  string `json:"generatedBy"`
+}
+
+// CreateAccessToken creates a personal access token for a user in MSR.
+func (c *Client) CreateAccessToken(ctx context.Context, userName string, label string) (ResponseAccessToken, error) {
+	if userName == "" {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token failed. %w: no user name", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(UpdateAccessToken{TokenLabel: label})
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token for %s failed. %w: %s", userName, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("api_tokens"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token for %s failed. %w: %s", userName, ErrRequestCreation, err)
+	}
+	q := req.URL.Query()
+	q.Add("username", userName)
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token for %s failed. %w", userName, err)
+	}
+
+	token := ResponseAccessToken{}
+	if err := json.Unmarshal(resBody, &token); err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token for %s failed. %w: %s", userName, ErrUnmarshaling, err)
+	}
+
+	return token, nil
+}
+
+// ReadAccessToken retrieves an access token from MSR by its hash.
+func (c *Client) ReadAccessToken(ctx context.Context, hashedToken string) (ResponseAccessToken, error) {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("api_tokens"), hashedToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s failed. %w: %s", hashedToken, ErrRequestCreation, err)
+	}
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s failed. %w", hashedToken, err)
+	}
+
+	token := ResponseAccessToken{}
+	if err := json.Unmarshal(resBody, &token); err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s failed. %w: %s", hashedToken, ErrUnmarshaling, err)
+	}
+
+	return token, nil
+}
+
+// UpdateAccessToken updates the label of an access token in MSR.
+func (c *Client) UpdateAccessToken(ctx context.Context, hashedToken string, token UpdateAccessToken) (ResponseAccessToken, error) {
+	body, err := json.Marshal(token)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("updating access token %s failed. %w: %s", hashedToken, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("api_tokens"), hashedToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("updating access token %s failed. %w: %s", hashedToken, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("updating access token %s failed. %w", hashedToken, err)
+	}
+
+	rToken := ResponseAccessToken{}
+	if err := json.Unmarshal(resBody, &rToken); err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("updating access token %s failed. %w: %s", hashedToken, ErrUnmarshaling, err)
+	}
+
+	return rToken, nil
+}
+
+// DeleteAccessToken revokes an access token in MSR.
+func (c *Client) DeleteAccessToken(ctx context.Context, hashedToken string) error {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("api_tokens"), hashedToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("revoking access token %s failed. %w: %s", hashedToken, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return fmt.Errorf("revoking access token %s failed. %w", hashedToken, err)
+	}
+
+	return nil
+}
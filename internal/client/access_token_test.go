@@ -0,0 +1,161 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+type testAccessTokenStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseAccessToken
+	expectedErr      error
+}
+
+func TestCreateAccessTokenSuccess(t *testing.T) {
+	token := client.ResponseAccessToken{
+		HashedToken: "fakehash",
+		TokenLabel:  "ci",
+		Token:       "faketoken",
+		IsActive:    true,
+		GeneratedBy: "admin",
+	}
+	mToken, err := json.Marshal(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/api/v0/api_tokens" || r.URL.Query().Get("username") != "ci-bot" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mToken); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: token,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateAccessToken(ctx, "ci-bot", "ci")
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreateAccessTokenEmpty(t *testing.T) {
+	testClient, err := client.NewDefaultClient("http://localhost", "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.CreateAccessToken(ctx, "", "ci")
+
+	if !errors.Is(err, client.ErrEmptyStruct) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrEmptyStruct, err)
+	}
+}
+
+func TestReadAccessTokenFailed(t *testing.T) {
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponseAccessToken{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadAccessToken(ctx, "fakehash")
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateAccessTokenSuccess(t *testing.T) {
+	token := client.ResponseAccessToken{
+		HashedToken: "fakehash",
+		TokenLabel:  "deploy",
+		IsActive:    true,
+	}
+	mToken, err := json.Marshal(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch || r.URL.Path != "/api/v0/api_tokens/fakehash" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mToken); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: token,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateAccessToken(ctx, "fakehash", client.UpdateAccessToken{TokenLabel: "deploy"})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestDeleteAccessTokenSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v0/api_tokens/fakehash" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+
+	if err := testClient.DeleteAccessToken(ctx, "fakehash"); err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 // CreateAccount struct.
@@ -14,17 +15,25 @@ type CreateAccount struct {
 	ID         string `json:"id"`
 	Password   string `json:"password"`
 	FullName   string `json:"fullName,omitempty"`
-	IsActive   bool   `json:"isActive,omitempty"`
+	IsActive   bool   `json:"isActive"`
 	IsAdmin    bool   `json:"isAdmin,omitempty"`
 	IsOrg      bool   `json:"isOrg,omitempty"`
 	SearchLDAP bool   `json:"searchLDAP,omitempty"`
 }
 
 // UpdateAccount struct.
+// All the fields are sent, so that an update can clear the full name and revoke the active and admin flags.
 type UpdateAccount struct {
-	FullName string `json:"fullName,omitempty"`
-	IsActive bool   `json:"isActive,omitempty"`
-	IsAdmin  bool   `json:"isAdmin,omitempty"`
+	FullName string `json:"fullName"`
+	IsActive bool   `json:"isActive"`
+	IsAdmin  bool   `json:"isAdmin"`
+}
+
+// ChangePassword struct.
+// The old password is only required when users change their own password.
+type ChangePassword struct {
+	OldPassword string `json:"oldPassword,omitempty"`
+	NewPassword string `json:"newPassword"`
 }
 
 // ResponseAccount struct.
@@ -46,7 +55,8 @@ type ResponseAccount struct {
 type AccountFilter string
 
 const (
-	Users         AccountFilter = "user"
+	AllAccounts   AccountFilter = "all"
+	Users         AccountFilter = "users"
 	Orgs          AccountFilter = "orgs"
 	Admins        AccountFilter = "admins"
 	NonAdmins     AccountFilter = "non-admins"
@@ -54,18 +64,38 @@ const (
 	InactiveUsers AccountFilter = "inactive-users"
 )
 
+// AccountFilters lists the filters accepted by the enzi accounts endpoint.
+var AccountFilters = []AccountFilter{AllAccounts, Users, Orgs, Admins, NonAdmins, ActiveUsers, InactiveUsers}
+
 // APIFormOfFilter is a string readable form of the AccountFilters enum.
 func (accF AccountFilter) APIFormOfFilter() string {
-	filters := [...]string{"users", "orgs", "admins", "non-admins", "active-users"}
-
-	x := string(accF)
-	for _, v := range filters {
-		if v == x {
-			return x
+	for _, v := range AccountFilters {
+		if v == accF {
+			return string(accF)
 		}
 	}
 
-	return "all"
+	return string(AllAccounts)
+}
+
+// Matches reports whether the account is one of those the filter retrieves.
+func (accF AccountFilter) Matches(acc ResponseAccount) bool {
+	switch AccountFilter(accF.APIFormOfFilter()) {
+	case Users:
+		return !acc.IsOrg
+	case Orgs:
+		return acc.IsOrg
+	case Admins:
+		return !acc.IsOrg && acc.IsAdmin
+	case NonAdmins:
+		return !acc.IsOrg && !acc.IsAdmin
+	case ActiveUsers:
+		return !acc.IsOrg && acc.IsActive
+	case InactiveUsers:
+		return !acc.IsOrg && !acc.IsActive
+	default:
+		return true
+	}
 }
 
 // CreateAccount method - checking the MSR health endpoint.
@@ -156,37 +186,122 @@ func (c *Client) UpdateAccount(ctx context.Context, id string, acc UpdateAccount
 	return resAcc, nil
 }
 
-// ReadAccounts method retrieves all accounts depending on the filter passed from the enzi endpoint.
-func (c *Client) ReadAccounts(ctx context.Context, accFilter AccountFilter) ([]ResponseAccount, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("accounts"), nil)
+// ChangeAccountPassword changes the password of a user in the enzi endpoint.
+func (c *Client) ChangeAccountPassword(ctx context.Context, id string, change ChangePassword) (ResponseAccount, error) {
+	if change.NewPassword == "" {
+		return ResponseAccount{}, fmt.Errorf("changing account %s password failed. %w", id, ErrEmptyStruct)
+	}
+	url := fmt.Sprintf("%s/%s/changePassword", c.createEnziUrl("accounts"), id)
+	body, err := json.Marshal(change)
+	if err != nil {
+		return ResponseAccount{}, fmt.Errorf("changing account %s password failed. %w: %s", id, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
 	if err != nil {
-		return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w: %s",
-			accFilter.APIFormOfFilter(), ErrRequestCreation, err)
+		return ResponseAccount{}, fmt.Errorf("changing account %s password failed. %w: %s", id, ErrRequestCreation, err)
 	}
 
-	q := req.URL.Query()
-	q.Add("filter", accFilter.APIFormOfFilter())
-	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Content-Type", "application/json")
 
-	body, err := c.doRequest(req)
+	resBody, err := c.doRequest(req)
 	if err != nil {
-		return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w",
-			accFilter.APIFormOfFilter(), err)
+		return ResponseAccount{}, fmt.Errorf("changing account %s password failed. %w", id, err)
 	}
 
-	accs := struct {
-		UsersCount    int    `json:"usersCount"`
-		OrgsCount     int    `json:"orgsCount"`
-		ResourceCount int    `json:"resourceCount"`
-		NextPageStart string `json:"nextPageStart"`
+	resAcc := ResponseAccount{}
+	if err := json.Unmarshal(resBody, &resAcc); err != nil {
+		return ResponseAccount{}, fmt.Errorf("changing account %s password failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+	return resAcc, nil
+}
 
-		Accounts []ResponseAccount `json:"accounts"`
-	}{}
+// accountsPageSize is the number of accounts requested per page.
+const accountsPageSize = 100
 
-	if err := json.Unmarshal(body, &accs); err != nil {
-		return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w: %s",
-			accFilter.APIFormOfFilter(), ErrUnmarshaling, err)
+// ReadAccounts method retrieves all accounts depending on the filter passed from the enzi endpoint.
+func (c *Client) ReadAccounts(ctx context.Context, accFilter AccountFilter) ([]ResponseAccount, error) {
+	accounts := []ResponseAccount{}
+	for start := ""; ; {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("accounts"), nil)
+		if err != nil {
+			return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w: %s",
+				accFilter.APIFormOfFilter(), ErrRequestCreation, err)
+		}
+
+		q := req.URL.Query()
+		q.Add("filter", accFilter.APIFormOfFilter())
+		q.Add("limit", strconv.Itoa(accountsPageSize))
+		if start != "" {
+			q.Add("start", start)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w",
+				accFilter.APIFormOfFilter(), err)
+		}
+
+		accs := struct {
+			UsersCount    int    `json:"usersCount"`
+			OrgsCount     int    `json:"orgsCount"`
+			ResourceCount int    `json:"resourceCount"`
+			NextPageStart string `json:"nextPageStart"`
+
+			Accounts []ResponseAccount `json:"accounts"`
+		}{}
+
+		if err := json.Unmarshal(body, &accs); err != nil {
+			return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w: %s",
+				accFilter.APIFormOfFilter(), ErrUnmarshaling, err)
+		}
+
+		accounts = append(accounts, accs.Accounts...)
+		if accs.NextPageStart == "" || accs.NextPageStart == start {
+			return accounts, nil
+		}
+		start = accs.NextPageStart
 	}
+}
 
-	return accs.Accounts, nil
+// ReadOrgMembers retrieves the accounts of all the members of an organization from the enzi endpoint.
+func (c *Client) ReadOrgMembers(ctx context.Context, orgID string) ([]ResponseAccount, error) {
+	accounts := []ResponseAccount{}
+	for start := ""; ; {
+		url := fmt.Sprintf("%s/%s/members", c.createEnziUrl("accounts"), orgID)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []ResponseAccount{}, fmt.Errorf("reading org %s members failed. %w: %s", orgID, ErrRequestCreation, err)
+		}
+
+		q := req.URL.Query()
+		q.Add("limit", strconv.Itoa(accountsPageSize))
+		if start != "" {
+			q.Add("start", start)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return []ResponseAccount{}, fmt.Errorf("reading org %s members failed. %w", orgID, err)
+		}
+
+		members := struct {
+			NextPageStart string `json:"nextPageStart"`
+			Members       []struct {
+				Member ResponseAccount `json:"member"`
+			} `json:"members"`
+		}{}
+		if err := json.Unmarshal(body, &members); err != nil {
+			return []ResponseAccount{}, fmt.Errorf("reading org %s members failed. %w: %s", orgID, ErrUnmarshaling, err)
+		}
+
+		for _, m := range members.Members {
+			accounts = append(accounts, m.Member)
+		}
+		if members.NextPageStart == "" || members.NextPageStart == start {
+			return accounts, nil
+		}
+		start = members.NextPageStart
+	}
 }
@@ -54,6 +54,41 @@ func TestCreateValidAccount(t *testing.T) {
 	}
 }
 
+func TestCreateInactiveAccount(t *testing.T) {
+	testResAcc := client.ResponseAccount{
+		ID:   "fake-test-id",
+		Name: "testuser",
+	}
+	mAccount, err := json.Marshal(testResAcc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acc := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&acc); err != nil {
+			t.Error(err)
+		}
+		// The inactive flag is sent, enzi would create an active account otherwise
+		if isActive, ok := acc["isActive"]; !ok || isActive != false {
+			t.Errorf("expected isActive false to be sent, got %v", acc)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mAccount); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if _, err := testClient.CreateAccount(ctx, client.CreateAccount{Name: testResAcc.Name, IsActive: false}); err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
 func TestCreateInvalidAccount(t *testing.T) {
 	testResAcc := client.ResponseAccount{}
 	mAccount, err := json.Marshal(testResAcc)
@@ -348,6 +383,65 @@ func TestUpdateAccountFailed(t *testing.T) {
 	}
 }
 
+func TestChangeAccountPasswordSuccess(t *testing.T) {
+	uAcc := client.ResponseAccount{
+		ID:   "fakeid",
+		Name: "fakeacc",
+	}
+	mUAcc, err := json.Marshal(uAcc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testAccountStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/enzi/v0/accounts/fakeid/changePassword" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			change := client.ChangePassword{}
+			if err := json.NewDecoder(r.Body).Decode(&change); err != nil {
+				t.Error(err)
+			}
+			if change.NewPassword != "newpassword" {
+				t.Errorf("unexpected new password %s", change.NewPassword)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mUAcc); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: uAcc,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ChangeAccountPassword(ctx, "fakeid", client.ChangePassword{OldPassword: "oldpassword", NewPassword: "newpassword"})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestChangeAccountPasswordEmpty(t *testing.T) {
+	testClient, err := client.NewDefaultClient("http://localhost", "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.ChangeAccountPassword(ctx, "fakeid", client.ChangePassword{OldPassword: "oldpassword"})
+
+	if !errors.Is(err, client.ErrEmptyStruct) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrEmptyStruct, err)
+	}
+}
+
 func TestReadAccountsSuccess(t *testing.T) {
 	resAccs := []client.ResponseAccount{}
 	resAccs = append(resAccs,
@@ -417,3 +511,97 @@ func TestReadAccountsFailed(t *testing.T) {
 		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
 	}
 }
+
+func TestAPIFormOfFilter(t *testing.T) {
+	for _, f := range client.AccountFilters {
+		if f.APIFormOfFilter() != string(f) {
+			t.Errorf("expected filter %s to be kept, got %s", f, f.APIFormOfFilter())
+		}
+	}
+	if got := client.AccountFilter("unknown").APIFormOfFilter(); got != "all" {
+		t.Errorf("expected unknown filter to map to all, got %s", got)
+	}
+}
+
+func TestAccountFilterMatches(t *testing.T) {
+	org := client.ResponseAccount{Name: "org", IsOrg: true, IsActive: true}
+	admin := client.ResponseAccount{Name: "admin", IsAdmin: true, IsActive: true}
+	inactive := client.ResponseAccount{Name: "inactive"}
+
+	tests := map[client.AccountFilter][]bool{
+		client.AllAccounts:   {true, true, true},
+		client.Users:         {false, true, true},
+		client.Orgs:          {true, false, false},
+		client.Admins:        {false, true, false},
+		client.NonAdmins:     {false, false, true},
+		client.ActiveUsers:   {false, true, false},
+		client.InactiveUsers: {false, false, true},
+	}
+	for f, expected := range tests {
+		for i, acc := range []client.ResponseAccount{org, admin, inactive} {
+			if f.Matches(acc) != expected[i] {
+				t.Errorf("filter %s: expected %s to match %t", f, acc.Name, expected[i])
+			}
+		}
+	}
+}
+
+func TestReadAccountsPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("filter") != "inactive-users" {
+			t.Errorf("unexpected filter %s", r.URL.Query().Get("filter"))
+		}
+		page := `{"accounts":[{"name":"mock1"}],"nextPageStart":"mock2"}`
+		if r.URL.Query().Get("start") == "mock2" {
+			page = `{"accounts":[{"name":"mock2"}],"nextPageStart":""}`
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadAccounts(ctx, client.InactiveUsers)
+
+	expected := []client.ResponseAccount{{Name: "mock1"}, {Name: "mock2"}}
+	if !reflect.DeepEqual(expected, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", expected, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestReadOrgMembersSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/enzi/v0/accounts/org/members" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"members":[{"isAdmin":true,"member":{"name":"mock1"}}]}`)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadOrgMembers(ctx, "org")
+
+	expected := []client.ResponseAccount{{Name: "mock1"}}
+	if !reflect.DeepEqual(expected, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", expected, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
@@ -14,6 +14,7 @@ const (
 	// MSRAPIVERSION the.
 	MSRAPIVERSION = "api/v0"
 	ENZIENDPOINT  = "enzi/v0"
+	HELMENDPOINT  = "charts/api"
 
 	// MsrURL - Default MSR URL.
 	DEFAULTMSRURL = "http://localhost:80"
@@ -82,10 +83,17 @@ func NewClient(username, password, MsrURL string, testMode bool, HTTPClient *htt
 
 // doRequest - performing the actual HTTP request.
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	body, _, err := c.doPagedRequest(req)
+	return body, err
+}
+
+// doPagedRequest runs the request like doRequest, also returning the start of the next page
+// that the MSR list endpoints send in the X-Next-Page-Start header.
+func (c *Client) doPagedRequest(req *http.Request) ([]byte, string, error) {
 	req.SetBasicAuth(c.Creds.Username, c.Creds.Password)
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	defer res.Body.Close()
@@ -93,28 +101,39 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	body, err := io.ReadAll(res.Body)
 
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if res.StatusCode >= http.StatusBadRequest {
 		if res.StatusCode == http.StatusUnauthorized {
-			return nil, fmt.Errorf("%w: Status code: %d", ErrUnauthorizedReq, res.StatusCode)
+			return nil, "", fmt.Errorf("%w: Status code: %d", ErrUnauthorizedReq, res.StatusCode)
 		}
-		errStruct := &ResponseError{}
-
-		if err := json.Unmarshal(body, errStruct); err != nil {
-			return nil, fmt.Errorf("%w: Status code: %d", ErrUnmarshaling, res.StatusCode)
+		err = responseError(res.StatusCode, body)
+		// MSR answers 404 for the resources deleted outside of Terraform
+		if res.StatusCode == http.StatusNotFound {
+			err = fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
 
-		if len(errStruct.Errors) <= 0 {
-			return nil, fmt.Errorf("%w: Status code: %d", ErrEmptyResError, res.StatusCode)
-		}
+		return nil, "", err
+	}
+
+	return body, res.Header.Get("X-Next-Page-Start"), err
+}
 
-		errMsg := errors.New(errStruct.Errors[0].Message)
+// responseError decodes the ResponseError body of a failed request.
+func responseError(statusCode int, body []byte) error {
+	errStruct := &ResponseError{}
 
-		return nil, fmt.Errorf("%w: Status code: %d. ErrMsg: %s", ErrResponseError, res.StatusCode, errMsg)
+	if err := json.Unmarshal(body, errStruct); err != nil {
+		return fmt.Errorf("%w: Status code: %d", ErrUnmarshaling, statusCode)
 	}
 
-	return body, err
+	if len(errStruct.Errors) <= 0 {
+		return fmt.Errorf("%w: Status code: %d", ErrEmptyResError, statusCode)
+	}
+
+	errMsg := errors.New(errStruct.Errors[0].Message)
+
+	return fmt.Errorf("%w: Status code: %d. ErrMsg: %s", ErrResponseError, statusCode, errMsg)
 }
 
 func (c *Client) createMsrUrl(endpoint string) string {
@@ -124,3 +143,7 @@ func (c *Client) createMsrUrl(endpoint string) string {
 func (c *Client) createEnziUrl(endpoint string) string {
 	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(c.MsrURL, "/"), ENZIENDPOINT, endpoint)
 }
+
+func (c *Client) createHelmUrl() string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(c.MsrURL, "/"), HELMENDPOINT)
+}
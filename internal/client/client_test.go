@@ -267,3 +267,44 @@ func TestEmptyPasswordField(t *testing.T) {
 		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
 	}
 }
+
+func TestDoRequestNotFound(t *testing.T) {
+	resError := client.ResponseError{
+		Errors: []client.Errors{
+			{
+				Code:    strconv.Itoa(http.StatusNotFound),
+				Message: "Not found",
+			},
+		},
+	}
+	bodyRes, err := json.Marshal(resError)
+	if err != nil {
+		t.Errorf("couldn't marshal struct %+v", resError)
+		return
+	}
+	tc := testClientStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			if _, err := w.Write(bodyRes); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: client.ErrNotFound,
+	}
+
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Fatalf("Couldn't create client")
+	}
+	ctx := context.Background()
+	_, err = testClient.ReadNamespaceTeamAccess(ctx, "org", "devs")
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v),\n got (%v)", tc.expectedErr, err)
+	}
+	// The MSR error is kept alongside the sentinel
+	if !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected (%v),\n got (%v)", client.ErrResponseError, err)
+	}
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Cron struct.
+type Cron struct {
+	ID          string            `json:"id,omitempty"`
+	Action      string            `json:"action"`
+	Schedule    string            `json:"schedule"`
+	Retries     int               `json:"retries"`
+	CapacityMap map[string]int    `json:"capacityMap,omitempty"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+	Deadline    string            `json:"deadline,omitempty"`
+	StopTimeout string            `json:"stopTimeout,omitempty"`
+	NextRun     string            `json:"nextRun,omitempty"`
+}
+
+// CreateCron creates the cron of an action in MSR, replacing any existing one for that action.
+func (c *Client) CreateCron(ctx context.Context, cron Cron) (Cron, error) {
+	if cron.Action == "" || cron.Schedule == "" {
+		return Cron{}, fmt.Errorf("creating cron failed. %w: %+v", ErrEmptyStruct, cron)
+	}
+	body, err := json.Marshal(cron)
+	if err != nil {
+		return Cron{}, fmt.Errorf("creating cron %s failed. %w: %s", cron.Action, ErrMarshaling, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("crons"), bytes.NewBuffer(body))
+	if err != nil {
+		return Cron{}, fmt.Errorf("creating cron %s failed. %w: %s", cron.Action, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return Cron{}, fmt.Errorf("creating cron %s failed. %w", cron.Action, err)
+	}
+
+	resCron := Cron{}
+	if err := json.Unmarshal(resBody, &resCron); err != nil {
+		return Cron{}, fmt.Errorf("creating cron %s failed. %w: %s", cron.Action, ErrUnmarshaling, err)
+	}
+
+	return resCron, nil
+}
+
+// ReadCron retrieves the cron of an action from MSR.
+func (c *Client) ReadCron(ctx context.Context, action string) (Cron, error) {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("crons"), action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Cron{}, fmt.Errorf("reading cron %s failed. %w: %s", action, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return Cron{}, fmt.Errorf("reading cron %s failed. %w", action, err)
+	}
+
+	resCron := Cron{}
+	if err := json.Unmarshal(body, &resCron); err != nil {
+		return Cron{}, fmt.Errorf("reading cron %s failed. %w: %s", action, ErrUnmarshaling, err)
+	}
+
+	return resCron, nil
+}
+
+// DeleteCron deletes the cron of an action from MSR.
+func (c *Client) DeleteCron(ctx context.Context, action string) error {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("crons"), action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting cron %s failed. %w: %s", action, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting cron %s failed. %w", action, err)
+	}
+
+	return nil
+}
+
+// ReadCrons retrieves all crons from MSR.
+func (c *Client) ReadCrons(ctx context.Context) ([]Cron, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("crons"), nil)
+	if err != nil {
+		return []Cron{}, fmt.Errorf("reading crons failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return []Cron{}, fmt.Errorf("reading crons failed. %w", err)
+	}
+
+	crons := struct {
+		Crons []Cron `json:"crons"`
+	}{}
+	if err := json.Unmarshal(body, &crons); err != nil {
+		return []Cron{}, fmt.Errorf("reading crons failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return crons.Crons, nil
+}
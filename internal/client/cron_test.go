@@ -0,0 +1,170 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+type testCronStruct struct {
+	server           *httptest.Server
+	expectedResponse client.Cron
+	expectedErr      error
+}
+
+func TestCreateCronSuccess(t *testing.T) {
+	resCron := client.Cron{
+		ID:       "fakeid",
+		Action:   client.OnlineGCAction,
+		Schedule: "0 0 1 * * 6",
+		NextRun:  "2023-01-07T01:00:00Z",
+	}
+	mResCron, err := json.Marshal(resCron)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			if _, err := w.Write(mResCron); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: resCron,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateCron(ctx, client.Cron{Action: client.OnlineGCAction, Schedule: "0 0 1 * * 6"})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreateCronEmpty(t *testing.T) {
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		})),
+		expectedResponse: client.Cron{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateCron(ctx, client.Cron{Action: client.OnlineGCAction})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadCronFailed(t *testing.T) {
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.Cron{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCron(ctx, client.OnlineGCAction)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestDeleteCronFailed(t *testing.T) {
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.DeleteCron(ctx, client.OnlineGCAction)
+
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadCronsSuccess(t *testing.T) {
+	resCrons := []client.Cron{
+		{ID: "fakeid1", Action: client.OnlineGCAction, Schedule: "0 0 1 * * 6"},
+		{ID: "fakeid2", Action: "update_vuln_db", Schedule: "@daily"},
+	}
+	mResCrons, err := json.Marshal(struct {
+		Crons []client.Cron `json:"crons"`
+	}{Crons: resCrons})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mResCrons); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCrons(ctx)
+
+	if !reflect.DeepEqual(resCrons, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", resCrons, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
@@ -10,8 +10,12 @@ var (
 	ErrEmptyResError           = errors.New("request returned empty ResponseError struct in MSR client")
 	ErrResponseError           = errors.New("request returned ResponseError in MSR client")
 	ErrUnauthorizedReq         = errors.New("unauthorized request in MSR client")
+	ErrNotFound                = errors.New("resource not found in MSR client")
 	ErrEmptyStruct             = errors.New("empty struct passed in MSR client")
 	ErrInvalidFilter           = errors.New("passing invalid account retrieval filter in MSR client")
 	ErrIDHasNoRepoName         = errors.New("ID doesn't contain repository name in MSR client")
 	ErrInvalidResourceIDFormat = errors.New("resource ID is invalid format")
+	ErrJobFailed               = errors.New("job did not finish successfully in MSR client")
+	ErrInvalidChartPackage     = errors.New("invalid helm chart package in MSR client")
+	ErrInvalidPasswordPolicy   = errors.New("invalid password generation policy in MSR client")
 )
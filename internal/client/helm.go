@@ -0,0 +1,185 @@
+package client
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// ChartVersion struct.
+type ChartVersion struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	AppVersion  string   `json:"appVersion,omitempty"`
+	Description string   `json:"description,omitempty"`
+	APIVersion  string   `json:"apiVersion,omitempty"`
+	Digest      string   `json:"digest,omitempty"`
+	Created     string   `json:"created,omitempty"`
+	URLs        []string `json:"urls,omitempty"`
+}
+
+// ReadCharts retrieves all the charts of a repo from MSR, keyed by chart name.
+func (c *Client) ReadCharts(ctx context.Context, orgName string, repoName string) (map[string][]ChartVersion, error) {
+	url := fmt.Sprintf("%s/%s/%s/charts", c.createHelmUrl(), orgName, repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return map[string][]ChartVersion{}, fmt.Errorf("reading charts for %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return map[string][]ChartVersion{}, fmt.Errorf("reading charts for %s/%s failed. %w", orgName, repoName, err)
+	}
+
+	charts := map[string][]ChartVersion{}
+	if err := json.Unmarshal(body, &charts); err != nil {
+		return map[string][]ChartVersion{}, fmt.Errorf("reading charts for %s/%s failed. %w: %s", orgName, repoName, ErrUnmarshaling, err)
+	}
+
+	return charts, nil
+}
+
+// ReadChartVersion retrieves a single version of a chart from MSR.
+func (c *Client) ReadChartVersion(ctx context.Context, orgName string, repoName string, name string, version string) (ChartVersion, error) {
+	url := fmt.Sprintf("%s/%s/%s/charts/%s/%s", c.createHelmUrl(), orgName, repoName, name, version)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ChartVersion{}, fmt.Errorf("reading chart %s-%s failed. %w: %s", name, version, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ChartVersion{}, fmt.Errorf("reading chart %s-%s failed. %w", name, version, err)
+	}
+
+	chart := ChartVersion{}
+	if err := json.Unmarshal(body, &chart); err != nil {
+		return ChartVersion{}, fmt.Errorf("reading chart %s-%s failed. %w: %s", name, version, ErrUnmarshaling, err)
+	}
+
+	return chart, nil
+}
+
+// UploadChart uploads a chart package to a repo in MSR.
+// An existing version of the chart is only overwritten when force is set.
+func (c *Client) UploadChart(ctx context.Context, orgName string, repoName string, pkg []byte, force bool) error {
+	if len(pkg) == 0 {
+		return fmt.Errorf("uploading chart to %s/%s failed. %w", orgName, repoName, ErrEmptyStruct)
+	}
+	url := fmt.Sprintf("%s/%s/%s/charts", c.createHelmUrl(), orgName, repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(pkg))
+	if err != nil {
+		return fmt.Errorf("uploading chart to %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+	}
+	if force {
+		q := req.URL.Query()
+		q.Add("force", "true")
+		req.URL.RawQuery = q.Encode()
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("uploading chart to %s/%s failed. %w", orgName, repoName, err)
+	}
+
+	return nil
+}
+
+// UploadChartPackage uploads the chart package found at a local path to a repo in MSR,
+// and returns the uploaded chart version.
+func (c *Client) UploadChartPackage(ctx context.Context, orgName string, repoName string, pkgPath string, force bool) (ChartVersion, error) {
+	pkg, err := os.ReadFile(pkgPath)
+	if err != nil {
+		return ChartVersion{}, fmt.Errorf("uploading chart %s failed. %w", pkgPath, err)
+	}
+	meta, err := ReadChartMetadata(pkg)
+	if err != nil {
+		return ChartVersion{}, fmt.Errorf("uploading chart %s failed. %w", pkgPath, err)
+	}
+
+	if err := c.UploadChart(ctx, orgName, repoName, pkg, force); err != nil {
+		return ChartVersion{}, err
+	}
+
+	return c.ReadChartVersion(ctx, orgName, repoName, meta.Name, meta.Version)
+}
+
+// DeleteChartVersion deletes a single version of a chart from MSR.
+func (c *Client) DeleteChartVersion(ctx context.Context, orgName string, repoName string, name string, version string) error {
+	url := fmt.Sprintf("%s/%s/%s/charts/%s/%s", c.createHelmUrl(), orgName, repoName, name, version)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting chart %s-%s failed. %w: %s", name, version, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting chart %s-%s failed. %w", name, version, err)
+	}
+
+	return nil
+}
+
+// ReadChartMetadata extracts the name and version of a chart from the Chart.yaml of its package.
+func ReadChartMetadata(pkg []byte) (ChartVersion, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(pkg))
+	if err != nil {
+		return ChartVersion{}, fmt.Errorf("%w: %s", ErrInvalidChartPackage, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return ChartVersion{}, fmt.Errorf("%w: Chart.yaml not found", ErrInvalidChartPackage)
+		}
+		if err != nil {
+			return ChartVersion{}, fmt.Errorf("%w: %s", ErrInvalidChartPackage, err)
+		}
+		// The chart metadata sits at the root of the chart directory, e.g. mychart/Chart.yaml
+		if path.Base(hdr.Name) != "Chart.yaml" || strings.Count(path.Clean(hdr.Name), "/") != 1 {
+			continue
+		}
+
+		meta := ChartVersion{}
+		scanner := bufio.NewScanner(tr)
+		for scanner.Scan() {
+			// Only the top level keys are of interest
+			key, value, found := strings.Cut(scanner.Text(), ":")
+			if !found || strings.HasPrefix(key, " ") || strings.HasPrefix(key, "\t") {
+				continue
+			}
+			value = strings.Trim(strings.TrimSpace(value), `"'`)
+			switch key {
+			case "name":
+				meta.Name = value
+			case "version":
+				meta.Version = value
+			case "appVersion":
+				meta.AppVersion = value
+			case "apiVersion":
+				meta.APIVersion = value
+			case "description":
+				meta.Description = value
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return ChartVersion{}, fmt.Errorf("%w: %s", ErrInvalidChartPackage, err)
+		}
+		if meta.Name == "" || meta.Version == "" {
+			return ChartVersion{}, fmt.Errorf("%w: Chart.yaml is missing the chart name or version", ErrInvalidChartPackage)
+		}
+
+		return meta, nil
+	}
+}
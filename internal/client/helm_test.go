@@ -0,0 +1,139 @@
+package client_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+// testChartPackage builds a chart package holding the given Chart.yaml.
+func testChartPackage(t *testing.T, chartYaml string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	files := map[string]string{
+		"mychart/Chart.yaml":            chartYaml,
+		"mychart/charts/dep/Chart.yaml": "name: dep\nversion: 0.0.1\n",
+	}
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadChartMetadata(t *testing.T) {
+	pkg := testChartPackage(t, "apiVersion: v2\nname: mychart\nversion: 1.2.3\nappVersion: \"4.5\"\nmaintainers:\n  - name: someone\n")
+
+	meta, err := client.ReadChartMetadata(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := client.ChartVersion{Name: "mychart", Version: "1.2.3", AppVersion: "4.5", APIVersion: "v2"}
+	if !reflect.DeepEqual(expected, meta) {
+		t.Errorf("expected metadata: (%+v),\n got (%+v)", expected, meta)
+	}
+}
+
+func TestReadChartMetadataInvalid(t *testing.T) {
+	if _, err := client.ReadChartMetadata([]byte("not a package")); !errors.Is(err, client.ErrInvalidChartPackage) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrInvalidChartPackage, err)
+	}
+	pkg := testChartPackage(t, "description: no name nor version\n")
+	if _, err := client.ReadChartMetadata(pkg); !errors.Is(err, client.ErrInvalidChartPackage) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrInvalidChartPackage, err)
+	}
+}
+
+func TestUploadChartPackageSuccess(t *testing.T) {
+	pkg := testChartPackage(t, "name: mychart\nversion: 1.2.3\n")
+	pkgPath := filepath.Join(t.TempDir(), "mychart-1.2.3.tgz")
+	if err := os.WriteFile(pkgPath, pkg, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	chart := client.ChartVersion{Name: "mychart", Version: "1.2.3", Digest: "fakedigest"}
+	mChart, err := json.Marshal(chart)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/charts/api/org/repo/charts":
+			if r.URL.Query().Get("force") != "true" {
+				t.Errorf("expected the upload to be forced")
+			}
+			w.WriteHeader(http.StatusCreated)
+			if _, err := w.Write([]byte(`{"saved":true}`)); err != nil {
+				t.Error(err)
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/charts/api/org/repo/charts/mychart/1.2.3":
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mChart); err != nil {
+				t.Error(err)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UploadChartPackage(ctx, "org", "repo", pkgPath, true)
+
+	if !reflect.DeepEqual(chart, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", chart, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestReadChartsFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(nil); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCharts(ctx, "org", "repo")
+
+	if !reflect.DeepEqual(map[string][]client.ChartVersion{}, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", map[string][]client.ChartVersion{}, resp)
+	}
+	if !errors.Is(err, client.ErrUnmarshaling) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrUnmarshaling, err)
+	}
+}
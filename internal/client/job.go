@@ -0,0 +1,205 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// OnlineGCAction is the job action running the online garbage collection.
+	OnlineGCAction = "onlinegc"
+
+	// DefaultJobPollInterval is the time waited between two job status checks.
+	DefaultJobPollInterval = 5 * time.Second
+)
+
+// Job statuses reported by MSR.
+const (
+	JobWaiting       = "waiting"
+	JobRunning       = "running"
+	JobDone          = "done"
+	JobCancelRequest = "cancel_request"
+	JobCanceled      = "canceled"
+	JobErrors        = "errors"
+	JobDeleted       = "deleted"
+)
+
+// CreateJob struct.
+type CreateJob struct {
+	Action      string            `json:"action"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+	Deadline    string            `json:"deadline,omitempty"`
+	StopTimeout string            `json:"stopTimeout,omitempty"`
+}
+
+// ResponseJob struct.
+type ResponseJob struct {
+	ID           string            `json:"id"`
+	RetryFromID  string            `json:"retryFromID"`
+	WorkerID     string            `json:"workerID"`
+	Status       string            `json:"status"`
+	ScheduledAt  string            `json:"scheduledAt"`
+	LastUpdated  string            `json:"lastUpdated"`
+	Action       string            `json:"action"`
+	RetriesLeft  int               `json:"retriesLeft"`
+	RetriesTotal int               `json:"retriesTotal"`
+	CapacityMap  map[string]int    `json:"capacityMap,omitempty"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+	Deadline     string            `json:"deadline"`
+	StopTimeout  string            `json:"stopTimeout"`
+}
+
+// IsFinished reports whether the job reached a terminal status.
+func (j ResponseJob) IsFinished() bool {
+	switch j.Status {
+	case JobDone, JobCanceled, JobErrors, JobDeleted:
+		return true
+	}
+	return false
+}
+
+// CreateJob schedules a job in MSR.
+func (c *Client) CreateJob(ctx context.Context, job CreateJob) (ResponseJob, error) {
+	if job.Action == "" {
+		return ResponseJob{}, fmt.Errorf("creating job failed. %w: %+v", ErrEmptyStruct, job)
+	}
+	body, err := json.Marshal(job)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating job %s failed. %w: %s", job.Action, ErrMarshaling, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("jobs"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating job %s failed. %w: %s", job.Action, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating job %s failed. %w", job.Action, err)
+	}
+
+	resJob := ResponseJob{}
+	if err := json.Unmarshal(resBody, &resJob); err != nil {
+		return ResponseJob{}, fmt.Errorf("creating job %s failed. %w: %s", job.Action, ErrUnmarshaling, err)
+	}
+
+	return resJob, nil
+}
+
+// ReadJob retrieves a job from MSR.
+func (c *Client) ReadJob(ctx context.Context, id string) (ResponseJob, error) {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("jobs"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("reading job %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("reading job %s failed. %w", id, err)
+	}
+
+	resJob := ResponseJob{}
+	if err := json.Unmarshal(body, &resJob); err != nil {
+		return ResponseJob{}, fmt.Errorf("reading job %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+
+	return resJob, nil
+}
+
+// WaitForJob polls a job until it reaches a terminal status or the context is done.
+// A job finishing in any other status than done is reported as ErrJobFailed.
+func (c *Client) WaitForJob(ctx context.Context, id string, interval time.Duration) (ResponseJob, error) {
+	return waitForJob(ctx, id, interval, c.ReadJob)
+}
+
+// waitForJob polls a job with the read function until it reaches a terminal status or the context is done.
+func waitForJob(ctx context.Context, id string, interval time.Duration, read func(context.Context, string) (ResponseJob, error)) (ResponseJob, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := read(ctx, id)
+		if err != nil {
+			return ResponseJob{}, err
+		}
+
+		if job.IsFinished() {
+			if job.Status != JobDone {
+				return job, fmt.Errorf("waiting for job %s failed. %w: status %s", id, ErrJobFailed, job.Status)
+			}
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, fmt.Errorf("waiting for job %s failed. %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// JobFilter narrows down the jobs retrieved from MSR.
+type JobFilter struct {
+	Action string
+	Worker string
+	// Status isn't filtered by MSR, the jobs are filtered as they are read
+	Status string
+	// Limit stops the paging once as many matching jobs are read, 0 reads them all
+	Limit int
+}
+
+// jobsPageSize is the number of jobs requested per page.
+const jobsPageSize = 100
+
+// ReadJobs retrieves the jobs matching the filter from MSR, most recent first.
+func (c *Client) ReadJobs(ctx context.Context, filter JobFilter) ([]ResponseJob, error) {
+	jobs := []ResponseJob{}
+	for start := 0; ; start += jobsPageSize {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("jobs"), nil)
+		if err != nil {
+			return []ResponseJob{}, fmt.Errorf("reading jobs failed. %w: %s", ErrRequestCreation, err)
+		}
+
+		q := req.URL.Query()
+		if filter.Action != "" {
+			q.Add("action", filter.Action)
+		}
+		if filter.Worker != "" {
+			q.Add("worker", filter.Worker)
+		}
+		q.Add("start", strconv.Itoa(start))
+		q.Add("limit", strconv.Itoa(jobsPageSize))
+		req.URL.RawQuery = q.Encode()
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return []ResponseJob{}, fmt.Errorf("reading jobs failed. %w", err)
+		}
+
+		page := struct {
+			Jobs []ResponseJob `json:"jobs"`
+		}{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []ResponseJob{}, fmt.Errorf("reading jobs failed. %w: %s", ErrUnmarshaling, err)
+		}
+
+		for _, job := range page.Jobs {
+			if filter.Status != "" && job.Status != filter.Status {
+				continue
+			}
+			jobs = append(jobs, job)
+			if filter.Limit > 0 && len(jobs) >= filter.Limit {
+				return jobs, nil
+			}
+		}
+		if len(page.Jobs) < jobsPageSize {
+			return jobs, nil
+		}
+	}
+}
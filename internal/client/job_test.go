@@ -0,0 +1,337 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+type testJobStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseJob
+	expectedErr      error
+}
+
+func TestCreateJobSuccess(t *testing.T) {
+	resJob := client.ResponseJob{
+		ID:     "fakeid",
+		Action: client.OnlineGCAction,
+		Status: client.JobWaiting,
+	}
+	mResJob, err := json.Marshal(resJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			if _, err := w.Write(mResJob); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: resJob,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateJob(ctx, client.CreateJob{Action: client.OnlineGCAction})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreateJobEmpty(t *testing.T) {
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		})),
+		expectedResponse: client.ResponseJob{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateJob(ctx, client.CreateJob{})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadJobFailed(t *testing.T) {
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponseJob{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadJob(ctx, "fakeid")
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestWaitForJobDone(t *testing.T) {
+	calls := 0
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			job := client.ResponseJob{ID: "fakeid", Status: client.JobRunning}
+			if calls > 1 {
+				job.Status = client.JobDone
+			}
+			mJob, err := json.Marshal(job)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mJob); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponseJob{ID: "fakeid", Status: client.JobDone},
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.WaitForJob(ctx, "fakeid", time.Millisecond)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestWaitForJobErrors(t *testing.T) {
+	resJob := client.ResponseJob{ID: "fakeid", Status: client.JobErrors}
+	mResJob, err := json.Marshal(resJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mResJob); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: resJob,
+		expectedErr:      client.ErrJobFailed,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.WaitForJob(ctx, "fakeid", time.Millisecond)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestWaitForJobContextDone(t *testing.T) {
+	resJob := client.ResponseJob{ID: "fakeid", Status: client.JobRunning}
+	mResJob, err := json.Marshal(resJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mResJob); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: resJob,
+		expectedErr:      context.DeadlineExceeded,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	resp, err := testClient.WaitForJob(ctx, "fakeid", 20*time.Millisecond)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) && !reflect.DeepEqual(client.ResponseJob{}, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadJobsPaginated(t *testing.T) {
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("action") != client.OnlineGCAction {
+				t.Errorf("expected action filter (%s), got (%s)", client.OnlineGCAction, r.URL.Query().Get("action"))
+			}
+			// A full first page followed by a partial one
+			size := 100
+			if r.URL.Query().Get("start") != "0" {
+				size = 2
+			}
+			page := struct {
+				Jobs []client.ResponseJob `json:"jobs"`
+			}{Jobs: make([]client.ResponseJob, size)}
+			mPage, err := json.Marshal(page)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mPage); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadJobs(ctx, client.JobFilter{Action: client.OnlineGCAction})
+
+	if len(resp) != 102 {
+		t.Errorf("expected (%d) jobs, got (%d)", 102, len(resp))
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadJobsLimit(t *testing.T) {
+	requests := 0
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			// Full pages where every other job is done, the paging only stops on the limit
+			page := struct {
+				Jobs []client.ResponseJob `json:"jobs"`
+			}{Jobs: make([]client.ResponseJob, 100)}
+			for i := range page.Jobs {
+				page.Jobs[i].Status = client.JobRunning
+				if i%2 == 0 {
+					page.Jobs[i].Status = client.JobDone
+				}
+			}
+			mPage, err := json.Marshal(page)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mPage); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadJobs(ctx, client.JobFilter{Status: client.JobDone, Limit: 60})
+
+	if len(resp) != 60 {
+		t.Errorf("expected (%d) jobs, got (%d)", 60, len(resp))
+	}
+	for _, job := range resp {
+		if job.Status != client.JobDone {
+			t.Errorf("expected only (%s) jobs, got (%s)", client.JobDone, job.Status)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected (%d) requests, got (%d)", 2, requests)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadJobsFailed(t *testing.T) {
+	tc := testJobStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadJobs(ctx, client.JobFilter{})
+
+	if !reflect.DeepEqual([]client.ResponseJob{}, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", []client.ResponseJob{}, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// LDAPSyncAction is the enzi job action synchronizing users and teams with LDAP.
+const LDAPSyncAction = "ldap-sync"
+
+// LDAPDomain struct.
+// The reader password is never returned by enzi.
+type LDAPDomain struct {
+	Domain             string `json:"domain,omitempty"`
+	ServerURL          string `json:"serverURL"`
+	NoSimplePagination bool   `json:"noSimplePagination"`
+	StartTLS           bool   `json:"startTLS"`
+	RootCerts          string `json:"rootCerts"`
+	TLSSkipVerify      bool   `json:"tlsSkipVerify"`
+	ReaderDN           string `json:"readerDN"`
+	ReaderPassword     string `json:"readerPassword,omitempty"`
+}
+
+// LDAPUserSearchConfig struct.
+type LDAPUserSearchConfig struct {
+	BaseDN               string `json:"baseDN"`
+	ScopeSubtree         bool   `json:"scopeSubtree"`
+	UsernameAttr         string `json:"usernameAttr"`
+	FullNameAttr         string `json:"fullNameAttr"`
+	Filter               string `json:"filter"`
+	MatchGroup           bool   `json:"matchGroup"`
+	MatchGroupDN         string `json:"matchGroupDN"`
+	MatchGroupMemberAttr string `json:"matchGroupMemberAttr"`
+	MatchGroupIterate    bool   `json:"matchGroupIterate"`
+}
+
+// MemberSyncOpts struct.
+// SelectGroupMembers syncs the members of GroupDN, otherwise the members are the results of the search.
+type MemberSyncOpts struct {
+	EnableSync         bool   `json:"enableSync"`
+	SelectGroupMembers bool   `json:"selectGroupMembers"`
+	GroupDN            string `json:"groupDN"`
+	GroupMemberAttr    string `json:"groupMemberAttr"`
+	SearchBaseDN       string `json:"searchBaseDN"`
+	SearchScopeSubtree bool   `json:"searchScopeSubtree"`
+	SearchFilter       string `json:"searchFilter"`
+}
+
+// LDAPSettings struct.
+type LDAPSettings struct {
+	LDAPDomain
+	AdditionalDomains   []LDAPDomain           `json:"additionalDomains"`
+	UserSearchConfigs   []LDAPUserSearchConfig `json:"userSearchConfigs"`
+	AdminSyncOpts       MemberSyncOpts         `json:"adminSyncOpts"`
+	SyncSchedule        string                 `json:"syncSchedule"`
+	JitUserProvisioning bool                   `json:"jitUserProvisioning"`
+}
+
+// ReadLDAPSettings retrieves the LDAP configuration from the enzi endpoint.
+func (c *Client) ReadLDAPSettings(ctx context.Context) (LDAPSettings, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("config/auth/ldap"), nil)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w", err)
+	}
+
+	settings := LDAPSettings{}
+	if err := json.Unmarshal(resBody, &settings); err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return settings, nil
+}
+
+// UpdateLDAPSettings replaces the LDAP configuration in the enzi endpoint.
+func (c *Client) UpdateLDAPSettings(ctx context.Context, settings LDAPSettings) (LDAPSettings, error) {
+	if settings.ServerURL == "" {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: no server URL", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.createEnziUrl("config/auth/ldap"), bytes.NewBuffer(body))
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w", err)
+	}
+
+	rSettings := LDAPSettings{}
+	if err := json.Unmarshal(resBody, &rSettings); err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return rSettings, nil
+}
+
+// ReadTeamMemberSyncConfig retrieves how the members of a team are synced with LDAP from the enzi endpoint.
+func (c *Client) ReadTeamMemberSyncConfig(ctx context.Context, orgID string, teamID string) (MemberSyncOpts, error) {
+	url := fmt.Sprintf("%s/%s/teams/%s/memberSyncConfig", c.createEnziUrl("accounts"), orgID, teamID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("reading team %s/%s LDAP sync failed. %w: %s", orgID, teamID, ErrRequestCreation, err)
+	}
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("reading team %s/%s LDAP sync failed. %w", orgID, teamID, err)
+	}
+
+	opts := MemberSyncOpts{}
+	if err := json.Unmarshal(resBody, &opts); err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("reading team %s/%s LDAP sync failed. %w: %s", orgID, teamID, ErrUnmarshaling, err)
+	}
+
+	return opts, nil
+}
+
+// UpdateTeamMemberSyncConfig sets how the members of a team are synced with LDAP in the enzi endpoint.
+// Syncing is turned off by sending options with EnableSync false.
+func (c *Client) UpdateTeamMemberSyncConfig(ctx context.Context, orgID string, teamID string, opts MemberSyncOpts) (MemberSyncOpts, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("updating team %s/%s LDAP sync failed. %w: %s", orgID, teamID, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/teams/%s/memberSyncConfig", c.createEnziUrl("accounts"), orgID, teamID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("updating team %s/%s LDAP sync failed. %w: %s", orgID, teamID, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("updating team %s/%s LDAP sync failed. %w", orgID, teamID, err)
+	}
+
+	rOpts := MemberSyncOpts{}
+	if err := json.Unmarshal(resBody, &rOpts); err != nil {
+		return MemberSyncOpts{}, fmt.Errorf("updating team %s/%s LDAP sync failed. %w: %s", orgID, teamID, ErrUnmarshaling, err)
+	}
+
+	return rOpts, nil
+}
+
+// CreateLDAPSyncJob schedules an immediate LDAP sync in the enzi endpoint.
+func (c *Client) CreateLDAPSyncJob(ctx context.Context) (ResponseJob, error) {
+	body, err := json.Marshal(CreateJob{Action: LDAPSyncAction})
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating LDAP sync job failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createEnziUrl("jobs"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating LDAP sync job failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating LDAP sync job failed. %w", err)
+	}
+
+	resJob := ResponseJob{}
+	if err := json.Unmarshal(resBody, &resJob); err != nil {
+		return ResponseJob{}, fmt.Errorf("creating LDAP sync job failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return resJob, nil
+}
+
+// ReadEnziJob retrieves a job from the enzi endpoint.
+func (c *Client) ReadEnziJob(ctx context.Context, id string) (ResponseJob, error) {
+	url := fmt.Sprintf("%s/%s", c.createEnziUrl("jobs"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("reading enzi job %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("reading enzi job %s failed. %w", id, err)
+	}
+
+	resJob := ResponseJob{}
+	if err := json.Unmarshal(body, &resJob); err != nil {
+		return ResponseJob{}, fmt.Errorf("reading enzi job %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+
+	return resJob, nil
+}
+
+// WaitForEnziJob polls an enzi job until it reaches a terminal status or the context is done.
+func (c *Client) WaitForEnziJob(ctx context.Context, id string, interval time.Duration) (ResponseJob, error) {
+	return waitForJob(ctx, id, interval, c.ReadEnziJob)
+}
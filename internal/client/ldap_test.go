@@ -0,0 +1,179 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+func TestUpdateLDAPSettingsSuccess(t *testing.T) {
+	settings := client.LDAPSettings{
+		LDAPDomain: client.LDAPDomain{
+			ServerURL: "ldaps://ldap.example.com",
+			ReaderDN:  "cn=reader,dc=example,dc=com",
+		},
+		AdditionalDomains: []client.LDAPDomain{},
+		UserSearchConfigs: []client.LDAPUserSearchConfig{
+			{BaseDN: "ou=people,dc=example,dc=com", UsernameAttr: "uid", ScopeSubtree: true},
+		},
+		SyncSchedule: "@hourly",
+	}
+	mSettings, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/enzi/v0/config/auth/ldap" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		sent := client.LDAPSettings{}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Error(err)
+		}
+		if sent.ReaderPassword != "secret" {
+			t.Errorf("expected the reader password to be sent, got %q", sent.ReaderPassword)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mSettings); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	update := settings
+	update.ReaderPassword = "secret"
+	resp, err := testClient.UpdateLDAPSettings(ctx, update)
+
+	if !reflect.DeepEqual(settings, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", settings, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestUpdateLDAPSettingsEmpty(t *testing.T) {
+	testClient, err := client.NewDefaultClient("http://localhost", "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.UpdateLDAPSettings(ctx, client.LDAPSettings{})
+
+	if !errors.Is(err, client.ErrEmptyStruct) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrEmptyStruct, err)
+	}
+}
+
+func TestReadLDAPSettingsFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(nil); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadLDAPSettings(ctx)
+
+	if !reflect.DeepEqual(client.LDAPSettings{}, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", client.LDAPSettings{}, resp)
+	}
+	if !errors.Is(err, client.ErrUnmarshaling) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrUnmarshaling, err)
+	}
+}
+
+func TestUpdateTeamMemberSyncConfigSuccess(t *testing.T) {
+	opts := client.MemberSyncOpts{
+		EnableSync:         true,
+		SelectGroupMembers: true,
+		GroupDN:            "cn=devs,ou=groups,dc=example,dc=com",
+		GroupMemberAttr:    "member",
+	}
+	mOpts, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/enzi/v0/accounts/org/teams/devs/memberSyncConfig" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mOpts); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateTeamMemberSyncConfig(ctx, "org", "devs", opts)
+
+	if !reflect.DeepEqual(opts, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", opts, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestLDAPSyncJobWait(t *testing.T) {
+	job := client.ResponseJob{ID: "fakeid", Action: client.LDAPSyncAction, Status: client.JobWaiting}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/enzi/v0/jobs":
+		case r.Method == http.MethodGet && r.URL.Path == "/enzi/v0/jobs/fakeid":
+			job.Status = client.JobDone
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		mJob, err := json.Marshal(job)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mJob); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	created, err := testClient.CreateLDAPSyncJob(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got (%v)", err)
+	}
+	finished, err := testClient.WaitForEnziJob(ctx, created.ID, time.Millisecond)
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+	if finished.Status != client.JobDone {
+		t.Errorf("expected status %s, got %s", client.JobDone, finished.Status)
+	}
+}
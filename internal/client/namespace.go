@@ -0,0 +1,78 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// NamespaceTeamAccess is the access level granted to a team on all the repos of a namespace.
+type NamespaceTeamAccess struct {
+	AccessLevel string `json:"accessLevel"`
+	Team        Team   `json:"team"`
+}
+
+// UpdateNamespaceTeamAccess grants a team access to all the repos of a namespace in MSR,
+// replacing any existing grant.
+func (c *Client) UpdateNamespaceTeamAccess(ctx context.Context, orgName string, teamName string, accessLevel string) (NamespaceTeamAccess, error) {
+	body, err := json.Marshal(struct {
+		AccessLevel string `json:"accessLevel"`
+	}{AccessLevel: accessLevel})
+	if err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("granting team %s access to namespace %s failed. %w: %s", teamName, orgName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/teamAccess/%s", c.createMsrUrl("repositoryNamespaces"), orgName, teamName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("granting team %s access to namespace %s failed. %w: %s", teamName, orgName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("granting team %s access to namespace %s failed. %w", teamName, orgName, err)
+	}
+
+	access := NamespaceTeamAccess{}
+	if err := json.Unmarshal(resBody, &access); err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("granting team %s access to namespace %s failed. %w: %s", teamName, orgName, ErrUnmarshaling, err)
+	}
+
+	return access, nil
+}
+
+// ReadNamespaceTeamAccess retrieves the access a team was granted to a namespace from MSR.
+func (c *Client) ReadNamespaceTeamAccess(ctx context.Context, orgName string, teamName string) (NamespaceTeamAccess, error) {
+	url := fmt.Sprintf("%s/%s/teamAccess/%s", c.createMsrUrl("repositoryNamespaces"), orgName, teamName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("reading team %s access to namespace %s failed. %w: %s", teamName, orgName, ErrRequestCreation, err)
+	}
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("reading team %s access to namespace %s failed. %w", teamName, orgName, err)
+	}
+
+	access := NamespaceTeamAccess{}
+	if err := json.Unmarshal(resBody, &access); err != nil {
+		return NamespaceTeamAccess{}, fmt.Errorf("reading team %s access to namespace %s failed. %w: %s", teamName, orgName, ErrUnmarshaling, err)
+	}
+
+	return access, nil
+}
+
+// DeleteNamespaceTeamAccess revokes the access a team was granted to a namespace in MSR.
+func (c *Client) DeleteNamespaceTeamAccess(ctx context.Context, orgName string, teamName string) error {
+	url := fmt.Sprintf("%s/%s/teamAccess/%s", c.createMsrUrl("repositoryNamespaces"), orgName, teamName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("revoking team %s access to namespace %s failed. %w: %s", teamName, orgName, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return fmt.Errorf("revoking team %s access to namespace %s failed. %w", teamName, orgName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+type testNamespaceTeamAccessStruct struct {
+	server           *httptest.Server
+	expectedResponse client.NamespaceTeamAccess
+	expectedErr      error
+}
+
+func TestUpdateNamespaceTeamAccessSuccess(t *testing.T) {
+	access := client.NamespaceTeamAccess{
+		AccessLevel: client.RepoAccessReadWrite,
+		Team:        client.Team{Name: "devs", ID: "fakeid"},
+	}
+	mAccess, err := json.Marshal(access)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testNamespaceTeamAccessStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/api/v0/repositoryNamespaces/org/teamAccess/devs" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mAccess); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: access,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateNamespaceTeamAccess(ctx, "org", "devs", client.RepoAccessReadWrite)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadNamespaceTeamAccessFailed(t *testing.T) {
+	tc := testNamespaceTeamAccessStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.NamespaceTeamAccess{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadNamespaceTeamAccess(ctx, "org", "devs")
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestDeleteNamespaceTeamAccessFailed(t *testing.T) {
+	tc := testNamespaceTeamAccessStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.DeleteNamespaceTeamAccess(ctx, "org", "devs")
+
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
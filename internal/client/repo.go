@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 type CreateRepo struct {
@@ -91,6 +92,50 @@ func (c *Client) ReadRepo(ctx context.Context, orgName string, repoName string)
 	return repo, nil
 }
 
+// reposPageSize is the number of repos requested per page.
+const reposPageSize = 100
+
+// ReadRepos retrieves all the repos of MSR, or of a single namespace when it isn't empty.
+func (c *Client) ReadRepos(ctx context.Context, namespace string) ([]ResponseRepo, error) {
+	url := c.createMsrUrl("repositories")
+	if namespace != "" {
+		url = fmt.Sprintf("%s/%s", url, namespace)
+	}
+
+	repos := []ResponseRepo{}
+	for start := ""; ; {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []ResponseRepo{}, fmt.Errorf("reading repos failed. %w: %s", ErrRequestCreation, err)
+		}
+
+		q := req.URL.Query()
+		q.Add("pageSize", strconv.Itoa(reposPageSize))
+		if start != "" {
+			q.Add("pageStart", start)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		body, next, err := c.doPagedRequest(req)
+		if err != nil {
+			return []ResponseRepo{}, fmt.Errorf("reading repos failed. %w", err)
+		}
+
+		page := struct {
+			Repositories []ResponseRepo `json:"repositories"`
+		}{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []ResponseRepo{}, fmt.Errorf("reading repos failed. %w: %s", ErrUnmarshaling, err)
+		}
+
+		repos = append(repos, page.Repositories...)
+		if next == "" || next == start {
+			return repos, nil
+		}
+		start = next
+	}
+}
+
 // UpdateRepo updates a repo in the MSR endpoint.
 func (c *Client) UpdateRepo(ctx context.Context, orgName string, repoName string, repo UpdateRepo) (ResponseRepo, error) {
 	if (repo == UpdateRepo{}) {
@@ -137,3 +182,103 @@ func (c *Client) DeleteRepo(ctx context.Context, orgName string, repoName string
 
 	return err
 }
+
+// Repository access levels.
+const (
+	RepoAccessReadOnly  = "read-only"
+	RepoAccessReadWrite = "read-write"
+	RepoAccessAdmin     = "admin"
+)
+
+// RepoUserAccess is the access level granted to a user on a repo.
+type RepoUserAccess struct {
+	AccessLevel string          `json:"accessLevel"`
+	User        ResponseAccount `json:"user"`
+}
+
+type repoUserAccessList struct {
+	UserAccessList []RepoUserAccess `json:"userAccessList"`
+	Repository     ResponseRepo     `json:"repository"`
+}
+
+// UpdateRepoUserAccess grants a user access to a repo in MSR, replacing any existing grant.
+func (c *Client) UpdateRepoUserAccess(ctx context.Context, orgName string, repoName string, userName string, accessLevel string) (RepoUserAccess, error) {
+	body, err := json.Marshal(struct {
+		AccessLevel string `json:"accessLevel"`
+	}{AccessLevel: accessLevel})
+	if err != nil {
+		return RepoUserAccess{}, fmt.Errorf("granting %s access to repo %s/%s failed. %w: %s", userName, orgName, repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/%s/userAccess/%s", c.createMsrUrl("repositories"), orgName, repoName, userName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return RepoUserAccess{}, fmt.Errorf("granting %s access to repo %s/%s failed. %w: %s", userName, orgName, repoName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return RepoUserAccess{}, fmt.Errorf("granting %s access to repo %s/%s failed. %w", userName, orgName, repoName, err)
+	}
+
+	access := RepoUserAccess{}
+	if err := json.Unmarshal(resBody, &access); err != nil {
+		return RepoUserAccess{}, fmt.Errorf("granting %s access to repo %s/%s failed. %w: %s", userName, orgName, repoName, ErrUnmarshaling, err)
+	}
+
+	return access, nil
+}
+
+// ReadRepoUserAccess retrieves the access a user was granted to a repo from MSR.
+func (c *Client) ReadRepoUserAccess(ctx context.Context, orgName string, repoName string, userName string) (RepoUserAccess, error) {
+	url := fmt.Sprintf("%s/%s/%s/userAccess/%s", c.createMsrUrl("repositories"), orgName, repoName, userName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return RepoUserAccess{}, fmt.Errorf("reading %s access to repo %s/%s failed. %w: %s", userName, orgName, repoName, ErrRequestCreation, err)
+	}
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return RepoUserAccess{}, fmt.Errorf("reading %s access to repo %s/%s failed. %w", userName, orgName, repoName, err)
+	}
+
+	access := RepoUserAccess{}
+	if err := json.Unmarshal(resBody, &access); err != nil {
+		return RepoUserAccess{}, fmt.Errorf("reading %s access to repo %s/%s failed. %w: %s", userName, orgName, repoName, ErrUnmarshaling, err)
+	}
+
+	return access, nil
+}
+
+// ReadRepoUserAccesses retrieves all the users granted access to a repo from MSR.
+func (c *Client) ReadRepoUserAccesses(ctx context.Context, orgName string, repoName string) ([]RepoUserAccess, error) {
+	url := fmt.Sprintf("%s/%s/%s/userAccess", c.createMsrUrl("repositories"), orgName, repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return []RepoUserAccess{}, fmt.Errorf("reading user access to repo %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+	}
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return []RepoUserAccess{}, fmt.Errorf("reading user access to repo %s/%s failed. %w", orgName, repoName, err)
+	}
+
+	list := repoUserAccessList{}
+	if err := json.Unmarshal(resBody, &list); err != nil {
+		return []RepoUserAccess{}, fmt.Errorf("reading user access to repo %s/%s failed. %w: %s", orgName, repoName, ErrUnmarshaling, err)
+	}
+
+	return list.UserAccessList, nil
+}
+
+// DeleteRepoUserAccess revokes the access a user was granted to a repo in MSR.
+func (c *Client) DeleteRepoUserAccess(ctx context.Context, orgName string, repoName string, userName string) error {
+	url := fmt.Sprintf("%s/%s/%s/userAccess/%s", c.createMsrUrl("repositories"), orgName, repoName, userName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("revoking %s access to repo %s/%s failed. %w: %s", userName, orgName, repoName, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return fmt.Errorf("revoking %s access to repo %s/%s failed. %w", userName, orgName, repoName, err)
+	}
+
+	return nil
+}
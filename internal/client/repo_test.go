@@ -348,3 +348,148 @@ func TestUpdateRepoFailed(t *testing.T) {
 		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
 	}
 }
+
+func TestUpdateRepoUserAccessSuccess(t *testing.T) {
+	access := client.RepoUserAccess{
+		AccessLevel: client.RepoAccessReadWrite,
+		User:        client.ResponseAccount{Name: "ci", ID: "fakeid"},
+	}
+	mAccess, err := json.Marshal(access)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v0/repositories/someone/repo/userAccess/ci" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mAccess); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateRepoUserAccess(ctx, "someone", "repo", "ci", client.RepoAccessReadWrite)
+	if !reflect.DeepEqual(access, resp) {
+		t.Errorf("expected (%v), got (%v)", access, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestReadRepoUserAccessesSuccess(t *testing.T) {
+	accesses := []client.RepoUserAccess{
+		{AccessLevel: client.RepoAccessReadOnly, User: client.ResponseAccount{Name: "reader"}},
+		{AccessLevel: client.RepoAccessAdmin, User: client.ResponseAccount{Name: "owner"}},
+	}
+	mAccesses, err := json.Marshal(map[string]any{"userAccessList": accesses})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mAccesses); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepoUserAccesses(ctx, "someone", "repo")
+	if !reflect.DeepEqual(accesses, resp) {
+		t.Errorf("expected (%v), got (%v)", accesses, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestDeleteRepoUserAccessFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		if _, err := w.Write(nil); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.DeleteRepoUserAccess(ctx, "someone", "repo", "ci"); !errors.Is(err, client.ErrUnmarshaling) {
+		t.Errorf("expected (%v), got (%v)", client.ErrUnmarshaling, err)
+	}
+}
+
+func TestReadReposPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/repositories/org" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		page := `{"repositories":[{"id":"1","name":"repo1","namespace":"org"}]}`
+		if r.URL.Query().Get("pageStart") == "2" {
+			page = `{"repositories":[{"id":"2","name":"repo2","namespace":"org"}]}`
+		} else {
+			w.Header().Set("X-Next-Page-Start", "2")
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepos(ctx, "org")
+
+	expected := []client.ResponseRepo{
+		{ID: "1", Name: "repo1", Namespace: "org"},
+		{ID: "2", Name: "repo2", Namespace: "org"},
+	}
+	if !reflect.DeepEqual(expected, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", expected, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestReadReposFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(nil); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.ReadRepos(ctx, "")
+
+	if !errors.Is(err, client.ErrUnmarshaling) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrUnmarshaling, err)
+	}
+}
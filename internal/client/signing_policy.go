@@ -0,0 +1,82 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Signers lists the teams and users whose signatures are required.
+// Teams are referenced as "org/team".
+type Signers struct {
+	Teams []string `json:"teams"`
+	Users []string `json:"users"`
+}
+
+// SigningPolicy describes the signatures required for the tags of a repo.
+type SigningPolicy struct {
+	Enforced   bool    `json:"enforced"`
+	Promotion  Signers `json:"promotion"`
+	Deployment Signers `json:"deployment"`
+}
+
+// UpdateSigningPolicy creates or replaces the signing policy of a repo in MSR.
+func (c *Client) UpdateSigningPolicy(ctx context.Context, orgName string, repoName string, policy SigningPolicy) (SigningPolicy, error) {
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return SigningPolicy{}, fmt.Errorf("updating signing policy for %s/%s failed. %w: %s", orgName, repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/%s/signingPolicy", c.createMsrUrl("repositories"), orgName, repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return SigningPolicy{}, fmt.Errorf("updating signing policy for %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return SigningPolicy{}, fmt.Errorf("updating signing policy for %s/%s failed. %w", orgName, repoName, err)
+	}
+
+	resPolicy := SigningPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return SigningPolicy{}, fmt.Errorf("updating signing policy for %s/%s failed. %w: %s", orgName, repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// ReadSigningPolicy reads the signing policy of a repo in MSR.
+func (c *Client) ReadSigningPolicy(ctx context.Context, orgName string, repoName string) (SigningPolicy, error) {
+	url := fmt.Sprintf("%s/%s/%s/signingPolicy", c.createMsrUrl("repositories"), orgName, repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return SigningPolicy{}, fmt.Errorf("reading signing policy for %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+	}
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return SigningPolicy{}, fmt.Errorf("reading signing policy for %s/%s failed. %w", orgName, repoName, err)
+	}
+
+	resPolicy := SigningPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return SigningPolicy{}, fmt.Errorf("reading signing policy for %s/%s failed. %w: %s", orgName, repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// DeleteSigningPolicy removes the signing policy of a repo in MSR.
+func (c *Client) DeleteSigningPolicy(ctx context.Context, orgName string, repoName string) error {
+	url := fmt.Sprintf("%s/%s/%s/signingPolicy", c.createMsrUrl("repositories"), orgName, repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting signing policy for %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+	}
+	if _, err = c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting signing policy for %s/%s failed. %w", orgName, repoName, err)
+	}
+
+	return nil
+}
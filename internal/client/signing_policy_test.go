@@ -0,0 +1,159 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+type testSigningPolicyStruct struct {
+	server           *httptest.Server
+	expectedResponse client.SigningPolicy
+	expectedErr      error
+}
+
+func TestUpdateSigningPolicySuccess(t *testing.T) {
+	policy := client.SigningPolicy{
+		Enforced:   true,
+		Promotion:  client.Signers{Teams: []string{"org/release"}, Users: []string{}},
+		Deployment: client.Signers{Teams: []string{}, Users: []string{"ci"}},
+	}
+	mPolicy, err := json.Marshal(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testSigningPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("expected method (%s), got (%s)", http.MethodPut, r.Method)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mPolicy); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: policy,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateSigningPolicy(ctx, "org", "repo", policy)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadSigningPolicyFailed(t *testing.T) {
+	tc := testSigningPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.SigningPolicy{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadSigningPolicy(ctx, "org", "repo")
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadRepoTagsSuccess(t *testing.T) {
+	tags := []client.ResponseTag{
+		{Name: "1.0", Digest: "sha256:fake1", InNotary: true, Signers: []string{"targets/releases"}},
+		{Name: "latest", Digest: "sha256:fake2"},
+	}
+	mTags, err := json.Marshal(tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mTags); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepoTags(ctx, "org", "repo")
+
+	if !reflect.DeepEqual(tags, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tags, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+	if !resp[0].IsSigned() || resp[1].IsSigned() {
+		t.Errorf("expected only the first tag to be signed, got (%+v)", resp)
+	}
+}
+
+func TestReadRepoTagsPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/repositories/org/repo/tags" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		page := `[{"name":"1.0","digest":"sha256:fake1"}]`
+		if r.URL.Query().Get("pageStart") == "1.0" {
+			page = `[{"name":"latest","digest":"sha256:fake2"}]`
+		} else {
+			w.Header().Set("X-Next-Page-Start", "1.0")
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepoTags(ctx, "org", "repo")
+
+	expected := []client.ResponseTag{
+		{Name: "1.0", Digest: "sha256:fake1"},
+		{Name: "latest", Digest: "sha256:fake2"},
+	}
+	if !reflect.DeepEqual(expected, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", expected, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SAMLMapping struct.
+// Users whose assertion has the attribute set to the value join the org, and the team when set.
+type SAMLMapping struct {
+	Attribute string `json:"attribute"`
+	Value     string `json:"value"`
+	Org       string `json:"org"`
+	Team      string `json:"team,omitempty"`
+}
+
+// SAMLSettings struct.
+// The IdP metadata is either fetched from IdPMetadataURL or given as XML in IdPMetadata.
+type SAMLSettings struct {
+	Enabled        bool          `json:"enabled"`
+	IdPMetadataURL string        `json:"idpMetadataURL"`
+	IdPMetadata    string        `json:"idpMetadata"`
+	SPHost         string        `json:"spHost"`
+	RootCerts      string        `json:"rootCerts"`
+	TLSSkipVerify  bool          `json:"tlsSkipVerify"`
+	Mappings       []SAMLMapping `json:"mappings"`
+}
+
+// OIDCSettings struct.
+// The client secret is never returned by enzi.
+type OIDCSettings struct {
+	Enabled       bool     `json:"enabled"`
+	IssuerURL     string   `json:"issuerURL"`
+	ClientID      string   `json:"clientID"`
+	ClientSecret  string   `json:"clientSecret,omitempty"`
+	Scopes        []string `json:"scopes"`
+	UsernameClaim string   `json:"usernameClaim"`
+	GroupsClaim   string   `json:"groupsClaim"`
+	RootCerts     string   `json:"rootCerts"`
+	TLSSkipVerify bool     `json:"tlsSkipVerify"`
+}
+
+// ReadSAMLSettings retrieves the SAML configuration from the enzi endpoint.
+func (c *Client) ReadSAMLSettings(ctx context.Context) (SAMLSettings, error) {
+	settings := SAMLSettings{}
+	if err := c.readAuthConfig(ctx, "saml", &settings); err != nil {
+		return SAMLSettings{}, err
+	}
+	return settings, nil
+}
+
+// UpdateSAMLSettings replaces the SAML configuration in the enzi endpoint.
+func (c *Client) UpdateSAMLSettings(ctx context.Context, settings SAMLSettings) (SAMLSettings, error) {
+	if settings.SPHost == "" {
+		return SAMLSettings{}, fmt.Errorf("updating saml settings failed. %w: no service provider host", ErrEmptyStruct)
+	}
+	rSettings := SAMLSettings{}
+	if err := c.updateAuthConfig(ctx, "saml", settings, &rSettings); err != nil {
+		return SAMLSettings{}, err
+	}
+	return rSettings, nil
+}
+
+// ReadOIDCSettings retrieves the OIDC configuration from the enzi endpoint.
+func (c *Client) ReadOIDCSettings(ctx context.Context) (OIDCSettings, error) {
+	settings := OIDCSettings{}
+	if err := c.readAuthConfig(ctx, "oidc", &settings); err != nil {
+		return OIDCSettings{}, err
+	}
+	return settings, nil
+}
+
+// UpdateOIDCSettings replaces the OIDC configuration in the enzi endpoint.
+func (c *Client) UpdateOIDCSettings(ctx context.Context, settings OIDCSettings) (OIDCSettings, error) {
+	if settings.IssuerURL == "" || settings.ClientID == "" {
+		return OIDCSettings{}, fmt.Errorf("updating oidc settings failed. %w: no issuer URL or client ID", ErrEmptyStruct)
+	}
+	rSettings := OIDCSettings{}
+	if err := c.updateAuthConfig(ctx, "oidc", settings, &rSettings); err != nil {
+		return OIDCSettings{}, err
+	}
+	return rSettings, nil
+}
+
+// readAuthConfig unmarshals the configuration of an enzi authentication method into settings.
+func (c *Client) readAuthConfig(ctx context.Context, method string, settings any) error {
+	url := fmt.Sprintf("%s/%s", c.createEnziUrl("config/auth"), method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("reading %s settings failed. %w: %s", method, ErrRequestCreation, err)
+	}
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("reading %s settings failed. %w", method, err)
+	}
+
+	if err := json.Unmarshal(resBody, settings); err != nil {
+		return fmt.Errorf("reading %s settings failed. %w: %s", method, ErrUnmarshaling, err)
+	}
+
+	return nil
+}
+
+// updateAuthConfig replaces the configuration of an enzi authentication method, unmarshaling the result into rSettings.
+func (c *Client) updateAuthConfig(ctx context.Context, method string, settings any, rSettings any) error {
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("updating %s settings failed. %w: %s", method, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s", c.createEnziUrl("config/auth"), method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("updating %s settings failed. %w: %s", method, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("updating %s settings failed. %w", method, err)
+	}
+
+	if err := json.Unmarshal(resBody, rSettings); err != nil {
+		return fmt.Errorf("updating %s settings failed. %w: %s", method, ErrUnmarshaling, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+func TestUpdateSAMLSettingsSuccess(t *testing.T) {
+	settings := client.SAMLSettings{
+		Enabled:        true,
+		IdPMetadataURL: "https://idp.example.com/metadata",
+		SPHost:         "msr.example.com",
+		Mappings: []client.SAMLMapping{
+			{Attribute: "groups", Value: "devs", Org: "example", Team: "devs"},
+		},
+	}
+	mSettings, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/enzi/v0/config/auth/saml" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mSettings); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateSAMLSettings(ctx, settings)
+
+	if !reflect.DeepEqual(settings, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", settings, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestUpdateSAMLSettingsEmpty(t *testing.T) {
+	testClient, err := client.NewDefaultClient("http://localhost", "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.UpdateSAMLSettings(ctx, client.SAMLSettings{Enabled: true})
+
+	if !errors.Is(err, client.ErrEmptyStruct) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrEmptyStruct, err)
+	}
+}
+
+func TestReadOIDCSettingsSuccess(t *testing.T) {
+	settings := client.OIDCSettings{
+		Enabled:       true,
+		IssuerURL:     "https://idp.example.com",
+		ClientID:      "msr",
+		Scopes:        []string{"openid", "email"},
+		UsernameClaim: "email",
+	}
+	mSettings, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/enzi/v0/config/auth/oidc" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mSettings); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadOIDCSettings(ctx)
+
+	if !reflect.DeepEqual(settings, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", settings, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestReadOIDCSettingsFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(nil); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.ReadOIDCSettings(ctx)
+
+	if !errors.Is(err, client.ErrUnmarshaling) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrUnmarshaling, err)
+	}
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Storage drivers supported by MSR.
+const (
+	StorageFilesystem = "filesystem"
+	StorageS3         = "s3"
+	StorageAzure      = "azure"
+	StorageGCS        = "gcs"
+	StorageSwift      = "swift"
+)
+
+// StorageFilesystemDriver struct.
+type StorageFilesystemDriver struct {
+	RootDirectory string `json:"rootdirectory,omitempty"`
+}
+
+// StorageS3Driver struct.
+type StorageS3Driver struct {
+	Region         string `json:"region,omitempty"`
+	Bucket         string `json:"bucket"`
+	RegionEndpoint string `json:"regionendpoint,omitempty"`
+	AccessKey      string `json:"accesskey,omitempty"`
+	SecretKey      string `json:"secretkey,omitempty"`
+	RootDirectory  string `json:"rootdirectory,omitempty"`
+	StorageClass   string `json:"storageclass,omitempty"`
+	KeyID          string `json:"keyid,omitempty"`
+	Encrypt        bool   `json:"encrypt"`
+	Secure         bool   `json:"secure"`
+	V4Auth         bool   `json:"v4auth"`
+}
+
+// StorageAzureDriver struct.
+type StorageAzureDriver struct {
+	AccountName   string `json:"accountname"`
+	AccountKey    string `json:"accountkey,omitempty"`
+	Container     string `json:"container"`
+	Realm         string `json:"realm,omitempty"`
+	RootDirectory string `json:"rootdirectory,omitempty"`
+}
+
+// StorageGCSDriver struct.
+type StorageGCSDriver struct {
+	Bucket        string         `json:"bucket"`
+	RootDirectory string         `json:"rootdirectory,omitempty"`
+	Credentials   map[string]any `json:"credentials,omitempty"`
+}
+
+// StorageSwiftDriver struct.
+type StorageSwiftDriver struct {
+	AuthURL            string `json:"authurl"`
+	Username           string `json:"username"`
+	Password           string `json:"password,omitempty"`
+	Container          string `json:"container"`
+	Region             string `json:"region,omitempty"`
+	Tenant             string `json:"tenant,omitempty"`
+	TenantID           string `json:"tenantid,omitempty"`
+	Domain             string `json:"domain,omitempty"`
+	DomainID           string `json:"domainid,omitempty"`
+	Prefix             string `json:"prefix,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureskipverify"`
+}
+
+// StorageDelete struct.
+type StorageDelete struct {
+	Enabled bool `json:"enabled"`
+}
+
+// RegistryStorage is the storage section of the registry settings.
+// Only one of the drivers is expected to be set.
+type RegistryStorage struct {
+	Filesystem *StorageFilesystemDriver `json:"filesystem,omitempty"`
+	S3         *StorageS3Driver         `json:"s3,omitempty"`
+	Azure      *StorageAzureDriver      `json:"azure,omitempty"`
+	GCS        *StorageGCSDriver        `json:"gcs,omitempty"`
+	Swift      *StorageSwiftDriver      `json:"swift,omitempty"`
+	Delete     *StorageDelete           `json:"delete,omitempty"`
+}
+
+// Driver returns the name of the configured storage driver.
+func (s RegistryStorage) Driver() string {
+	switch {
+	case s.Filesystem != nil:
+		return StorageFilesystem
+	case s.S3 != nil:
+		return StorageS3
+	case s.Azure != nil:
+		return StorageAzure
+	case s.GCS != nil:
+		return StorageGCS
+	case s.Swift != nil:
+		return StorageSwift
+	}
+	return ""
+}
+
+type registrySettings struct {
+	Storage RegistryStorage `json:"storage"`
+}
+
+// storageDrivers are the keys of the drivers in the storage section of the registry settings.
+var storageDrivers = []string{StorageFilesystem, StorageS3, StorageAzure, StorageGCS, StorageSwift}
+
+// readRegistrySettings retrieves the registry settings from MSR as they are,
+// including the sections the client doesn't model.
+func (c *Client) readRegistrySettings(ctx context.Context) (map[string]json.RawMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("admin/settings/registry/simple"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnmarshaling, err)
+	}
+
+	return settings, nil
+}
+
+// ReadStorageSettings retrieves the registry storage settings from MSR.
+func (c *Client) ReadStorageSettings(ctx context.Context) (RegistryStorage, error) {
+	settings, err := c.readRegistrySettings(ctx)
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("reading storage settings failed. %w", err)
+	}
+
+	storage := RegistryStorage{}
+	if raw, ok := settings["storage"]; ok {
+		if err := json.Unmarshal(raw, &storage); err != nil {
+			return RegistryStorage{}, fmt.Errorf("reading storage settings failed. %w: %s", ErrUnmarshaling, err)
+		}
+	}
+
+	return storage, nil
+}
+
+// UpdateStorageSettings replaces the storage driver of the registry settings in MSR.
+// The current settings are read first so that the sections and the storage options
+// the client doesn't model are sent back unchanged.
+func (c *Client) UpdateStorageSettings(ctx context.Context, storage RegistryStorage) (RegistryStorage, error) {
+	if storage.Driver() == "" {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %+v", ErrEmptyStruct, storage)
+	}
+
+	settings, err := c.readRegistrySettings(ctx)
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w", err)
+	}
+
+	current := map[string]json.RawMessage{}
+	if raw, ok := settings["storage"]; ok {
+		if err := json.Unmarshal(raw, &current); err != nil {
+			return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrUnmarshaling, err)
+		}
+	}
+	// Only one driver is configured at a time, the previous one is dropped
+	for _, driver := range storageDrivers {
+		delete(current, driver)
+	}
+
+	mStorage, err := json.Marshal(storage)
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrMarshaling, err)
+	}
+	updated := map[string]json.RawMessage{}
+	if err := json.Unmarshal(mStorage, &updated); err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	for key, value := range updated {
+		current[key] = value
+	}
+
+	settings["storage"], err = json.Marshal(current)
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrMarshaling, err)
+	}
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrMarshaling, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.createMsrUrl("admin/settings/registry/simple"), bytes.NewBuffer(body))
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w", err)
+	}
+
+	rSettings := registrySettings{}
+	if err := json.Unmarshal(resBody, &rSettings); err != nil {
+		return RegistryStorage{}, fmt.Errorf("updating storage settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return rSettings.Storage, nil
+}
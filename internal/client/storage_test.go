@@ -0,0 +1,185 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+type testStorageStruct struct {
+	server           *httptest.Server
+	expectedResponse client.RegistryStorage
+	expectedErr      error
+}
+
+func TestReadStorageSettingsSuccess(t *testing.T) {
+	resStorage := client.RegistryStorage{
+		S3: &client.StorageS3Driver{
+			Region: "us-east-1",
+			Bucket: "fakebucket",
+			Secure: true,
+		},
+		Delete: &client.StorageDelete{Enabled: true},
+	}
+	mResStorage, err := json.Marshal(map[string]client.RegistryStorage{"storage": resStorage})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testStorageStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mResStorage); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: resStorage,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadStorageSettings(ctx)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if resp.Driver() != client.StorageS3 {
+		t.Errorf("expected driver: (%s),\n got (%s)", client.StorageS3, resp.Driver())
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateStorageSettingsEmpty(t *testing.T) {
+	tc := testStorageStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})),
+		expectedResponse: client.RegistryStorage{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateStorageSettings(ctx, client.RegistryStorage{Delete: &client.StorageDelete{}})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateStorageSettingsFailed(t *testing.T) {
+	tc := testStorageStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.RegistryStorage{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateStorageSettings(ctx, client.RegistryStorage{
+		Filesystem: &client.StorageFilesystemDriver{RootDirectory: "/storage"},
+	})
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateStorageSettingsMerged(t *testing.T) {
+	current := []byte(`{
+		"http": {"secret": "registry-secret"},
+		"storage": {
+			"s3": {"bucket": "fakebucket", "secure": true},
+			"delete": {"enabled": false},
+			"cache": {"blobdescriptor": "inmemory"}
+		}
+	}`)
+	resStorage := client.RegistryStorage{
+		Filesystem: &client.StorageFilesystemDriver{RootDirectory: "/storage"},
+		Delete:     &client.StorageDelete{Enabled: true},
+	}
+	tc := testStorageStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusOK)
+				if _, err := w.Write(current); err != nil {
+					t.Error(err)
+				}
+				return
+			}
+			sent := map[string]map[string]json.RawMessage{}
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Error(err)
+				return
+			}
+			// The sections and the storage options the client doesn't know are sent back
+			if string(sent["http"]["secret"]) != `"registry-secret"` {
+				t.Errorf("expected the http section to be kept, got (%s)", sent["http"])
+			}
+			if _, ok := sent["storage"]["cache"]; !ok {
+				t.Errorf("expected the storage cache to be kept, got (%s)", sent["storage"])
+			}
+			if _, ok := sent["storage"]["s3"]; ok {
+				t.Errorf("expected the previous s3 driver to be dropped, got (%s)", sent["storage"])
+			}
+			if string(sent["storage"]["delete"]) != `{"enabled":true}` {
+				t.Errorf("expected delete to be enabled, got (%s)", sent["storage"]["delete"])
+			}
+			mResStorage, err := json.Marshal(map[string]client.RegistryStorage{"storage": resStorage})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mResStorage); err != nil {
+				t.Error(err)
+			}
+		})),
+		expectedResponse: resStorage,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateStorageSettings(ctx, resStorage)
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ResponseTag struct.
+type ResponseTag struct {
+	Name         string   `json:"name"`
+	Digest       string   `json:"digest"`
+	Author       string   `json:"author"`
+	CreatedAt    string   `json:"createdAt"`
+	UpdatedAt    string   `json:"updatedAt"`
+	HashMismatch bool     `json:"hashMismatch"`
+	InNotary     bool     `json:"inNotary"`
+	Signers      []string `json:"signers,omitempty"`
+}
+
+// IsSigned reports whether the tag is signed and its signature matches the pushed image.
+func (t ResponseTag) IsSigned() bool {
+	return t.InNotary && !t.HashMismatch
+}
+
+// tagsPageSize is the number of tags requested per page.
+const tagsPageSize = 100
+
+// ReadRepoTags retrieves the tags of a repo from MSR, along with their trust data.
+func (c *Client) ReadRepoTags(ctx context.Context, orgName string, repoName string) ([]ResponseTag, error) {
+	url := fmt.Sprintf("%s/%s/%s/tags", c.createMsrUrl("repositories"), orgName, repoName)
+
+	tags := []ResponseTag{}
+	for start := ""; ; {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []ResponseTag{}, fmt.Errorf("reading tags for %s/%s failed. %w: %s", orgName, repoName, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		q.Add("includeManifests", "false")
+		q.Add("pageSize", strconv.Itoa(tagsPageSize))
+		if start != "" {
+			q.Add("pageStart", start)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		resBody, next, err := c.doPagedRequest(req)
+		if err != nil {
+			return []ResponseTag{}, fmt.Errorf("reading tags for %s/%s failed. %w", orgName, repoName, err)
+		}
+
+		page := []ResponseTag{}
+		if err := json.Unmarshal(resBody, &page); err != nil {
+			return []ResponseTag{}, fmt.Errorf("reading tags for %s/%s failed. %w: %s", orgName, repoName, ErrUnmarshaling, err)
+		}
+
+		tags = append(tags, page...)
+		if next == "" || next == start {
+			return tags, nil
+		}
+		start = next
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 type Team struct {
@@ -16,7 +17,8 @@ type Team struct {
 	OrgID        string `json:"orgID"`
 }
 
-type teamUsers struct {
+// TeamUsers struct.
+type TeamUsers struct {
 	Members []struct {
 		IsAdmin bool `json:"isAdmin"`
 		// There is aditional fields available no present in Account
@@ -72,6 +74,47 @@ func (c *Client) ReadTeam(ctx context.Context, orgID string, teamID string) (Tea
 	return team, nil
 }
 
+// teamsPageSize is the number of teams requested per page.
+const teamsPageSize = 100
+
+// ReadTeams retrieves all the teams of an organization from the enzi endpoint.
+func (c *Client) ReadTeams(ctx context.Context, orgID string) ([]Team, error) {
+	teams := []Team{}
+	for start := ""; ; {
+		url := fmt.Sprintf("%s/%s/teams", c.createEnziUrl("accounts"), orgID)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []Team{}, fmt.Errorf("reading org %s teams failed. %w: %s", orgID, ErrRequestCreation, err)
+		}
+
+		q := req.URL.Query()
+		q.Add("limit", strconv.Itoa(teamsPageSize))
+		if start != "" {
+			q.Add("start", start)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return []Team{}, fmt.Errorf("reading org %s teams failed. %w", orgID, err)
+		}
+
+		page := struct {
+			NextPageStart string `json:"nextPageStart"`
+			Teams         []Team `json:"teams"`
+		}{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []Team{}, fmt.Errorf("reading org %s teams failed. %w: %s", orgID, ErrUnmarshaling, err)
+		}
+
+		teams = append(teams, page.Teams...)
+		if page.NextPageStart == "" || page.NextPageStart == start {
+			return teams, nil
+		}
+		start = page.NextPageStart
+	}
+}
+
 // UpdateTeam updates a team in the enzi endpoint.
 func (c *Client) UpdateTeam(ctx context.Context, orgID string, team Team) (Team, error) {
 	url := fmt.Sprintf("%s/%s/teams/%s", c.createEnziUrl("accounts"), orgID, team.ID)
@@ -136,26 +179,42 @@ func (c *Client) AddUserToTeam(ctx context.Context, orgID string, teamID string,
 }
 
 // GetTeamUsers retrieves the users of a given team.
-func (c *Client) GetTeamUsers(ctx context.Context, orgID string, teamID string) (teamUsers, error) {
+func (c *Client) GetTeamUsers(ctx context.Context, orgID string, teamID string) (TeamUsers, error) {
 	endpoint := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams/%s/members", orgID, teamID))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return teamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
-	}
 
-	resBody, err := c.doRequest(req)
-	fmt.Printf("Body %+v", string(resBody))
-	if err != nil {
-		return teamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
-	}
+	tUsers := TeamUsers{}
+	for start := ""; ; {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return TeamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
+		}
+
+		q := req.URL.Query()
+		q.Add("limit", strconv.Itoa(teamsPageSize))
+		if start != "" {
+			q.Add("start", start)
+		}
+		req.URL.RawQuery = q.Encode()
 
-	tUsers := teamUsers{}
-	if err := json.Unmarshal(resBody, &tUsers); err != nil {
-		return teamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
+		resBody, err := c.doRequest(req)
+		if err != nil {
+			return TeamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
+		}
 
-	}
+		page := struct {
+			NextPageStart string `json:"nextPageStart"`
+			TeamUsers
+		}{}
+		if err := json.Unmarshal(resBody, &page); err != nil {
+			return TeamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
+		}
 
-	return tUsers, nil
+		tUsers.Members = append(tUsers.Members, page.Members...)
+		if page.NextPageStart == "" || page.NextPageStart == start {
+			return tUsers, nil
+		}
+		start = page.NextPageStart
+	}
 }
 
 // DeleteUserFromTeam deletes a user from a given team.
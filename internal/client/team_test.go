@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+func TestReadTeamsPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/enzi/v0/accounts/org/teams" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		page := `{"teams":[{"id":"1","name":"team1","orgID":"org"}],"nextPageStart":"team2"}`
+		if r.URL.Query().Get("start") == "team2" {
+			page = `{"teams":[{"id":"2","name":"team2","orgID":"org"}],"nextPageStart":""}`
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadTeams(ctx, "org")
+
+	expected := []client.Team{
+		{ID: "1", Name: "team1", OrgID: "org"},
+		{ID: "2", Name: "team2", OrgID: "org"},
+	}
+	if !reflect.DeepEqual(expected, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", expected, resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestGetTeamUsersPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/enzi/v0/accounts/org/teams/team1/members" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		page := `{"members":[{"isAdmin":true,"member":{"id":"1","name":"user1"}}],"nextPageStart":"user2"}`
+		if r.URL.Query().Get("start") == "user2" {
+			page = `{"members":[{"isAdmin":false,"member":{"id":"2","name":"user2"}}],"nextPageStart":""}`
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.GetTeamUsers(ctx, "org", "team1")
+
+	if len(resp.Members) != 2 || resp.Members[0].Member.ID != "1" || !resp.Members[0].IsAdmin ||
+		resp.Members[1].Member.ID != "2" || resp.Members[1].IsAdmin {
+		t.Errorf("expected the members of both pages, got (%+v)", resp)
+	}
+	if err != nil {
+		t.Errorf("expected no error, got (%v)", err)
+	}
+}
+
+func TestReadTeamsFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(nil); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", true)
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.ReadTeams(ctx, "org")
+
+	if !errors.Is(err, client.ErrUnmarshaling) {
+		t.Errorf("expected error: (%v),\n got (%v)", client.ErrUnmarshaling, err)
+	}
+}
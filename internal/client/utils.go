@@ -2,22 +2,99 @@ package client
 
 import (
 	"context"
-	"math/rand"
-	"time"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-// GeneratePass creates a random password.
-func GeneratePass() string {
-	var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ123456789!@#$")
-	rand.New(rand.NewSource(time.Now().UnixNano()))
-	b := make([]rune, 8)
+// Character classes used to generate passwords.
+const (
+	PasswordLower   = "abcdefghijklmnopqrstuvwxyz"
+	PasswordUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	PasswordNumeric = "0123456789"
+	PasswordSpecial = "!@#$%^&*()-_=+"
+)
+
+// PasswordPolicy describes the length and the character classes of a generated password.
+type PasswordPolicy struct {
+	Length  int
+	Lower   bool
+	Upper   bool
+	Numeric bool
+	Special bool
+}
+
+// DefaultPasswordPolicy is the policy used for the passwords of users created without one.
+var DefaultPasswordPolicy = PasswordPolicy{
+	Length:  16,
+	Lower:   true,
+	Upper:   true,
+	Numeric: true,
+	Special: true,
+}
+
+// GeneratePassword creates a random password from a cryptographically secure source.
+// The password contains at least one character of every enabled class.
+func GeneratePassword(policy PasswordPolicy) (string, error) {
+	var classes []string
+	for _, c := range []struct {
+		enabled bool
+		chars   string
+	}{
+		{policy.Lower, PasswordLower},
+		{policy.Upper, PasswordUpper},
+		{policy.Numeric, PasswordNumeric},
+		{policy.Special, PasswordSpecial},
+	} {
+		if c.enabled {
+			classes = append(classes, c.chars)
+		}
+	}
+	if len(classes) == 0 {
+		return "", fmt.Errorf("generating password failed. %w: no character class is enabled", ErrInvalidPasswordPolicy)
+	}
+	if policy.Length < len(classes) {
+		return "", fmt.Errorf("generating password failed. %w: length %d is shorter than the %d enabled character classes", ErrInvalidPasswordPolicy, policy.Length, len(classes))
+	}
+
+	all := strings.Join(classes, "")
+	b := make([]byte, policy.Length)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		// The first characters are taken from each class in turn, the rest from all of them.
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		c, err := randomInt(len(chars))
+		if err != nil {
+			return "", fmt.Errorf("generating password failed. %w", err)
+		}
+		b[i] = chars[c]
+	}
+
+	// Shuffle so that the mandatory characters aren't always at the start.
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", fmt.Errorf("generating password failed. %w", err)
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+
+	return string(b), nil
+}
+
+// randomInt returns a uniform random int in [0, max).
+func randomInt(max int) (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
 	}
-	return string(b)
+	return int(n.Int64()), nil
 }
 
 // PruningPolicyRulesToAPI converts slice of PruningPolicyRuleTFSDK rules to PruningPolicyRuleAPI.
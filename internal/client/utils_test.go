@@ -0,0 +1,56 @@
+package client_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+func TestGeneratePasswordClasses(t *testing.T) {
+	policy := client.PasswordPolicy{Length: 4, Upper: true, Numeric: true}
+	for i := 0; i < 50; i++ {
+		pass, err := client.GeneratePassword(policy)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pass) != policy.Length {
+			t.Errorf("expected length %d, got %d", policy.Length, len(pass))
+		}
+		if !strings.ContainsAny(pass, client.PasswordUpper) || !strings.ContainsAny(pass, client.PasswordNumeric) {
+			t.Errorf("expected upper case and numeric characters in %s", pass)
+		}
+		if strings.ContainsAny(pass, client.PasswordLower+client.PasswordSpecial) {
+			t.Errorf("unexpected lower case or special characters in %s", pass)
+		}
+	}
+}
+
+func TestGeneratePasswordDefault(t *testing.T) {
+	first, err := client.GeneratePassword(client.DefaultPasswordPolicy)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := client.GeneratePassword(client.DefaultPasswordPolicy)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first) != client.DefaultPasswordPolicy.Length {
+		t.Errorf("expected length %d, got %d", client.DefaultPasswordPolicy.Length, len(first))
+	}
+	if first == second {
+		t.Errorf("expected different passwords, got %s twice", first)
+	}
+}
+
+func TestGeneratePasswordInvalidPolicy(t *testing.T) {
+	for _, policy := range []client.PasswordPolicy{
+		{Length: 16},
+		{Length: 2, Lower: true, Upper: true, Numeric: true},
+	} {
+		if _, err := client.GeneratePassword(policy); !errors.Is(err, client.ErrInvalidPasswordPolicy) {
+			t.Errorf("expected error: (%v),\n got (%v)", client.ErrInvalidPasswordPolicy, err)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+// Package export writes the orgs, teams, repos and pruning policies of an MSR instance
+// as Terraform configuration, with the import blocks that adopt them.
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+)
+
+// Options of an export.
+type Options struct {
+	// Namespaces are the orgs and users to export, all the orgs are exported when empty.
+	Namespaces []string
+	// OutputDir is the directory the .tf files are written to, one file per namespace.
+	OutputDir string
+}
+
+// Run exports the namespaces read with the client and returns the written files.
+func Run(ctx context.Context, c client.Client, opts Options) ([]string, error) {
+	namespaces, err := readNamespaces(ctx, c, opts.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating the output directory failed: %w", err)
+	}
+
+	// The resource names are unique across the files, they share the module
+	labels := map[string]bool{}
+	files := []string{}
+	for _, namespace := range namespaces {
+		g := newGenerator(ctx, c, labels)
+		if err := g.namespace(namespace); err != nil {
+			return files, err
+		}
+		// A user namespace without repos has nothing to export
+		if g.empty {
+			continue
+		}
+
+		file := filepath.Join(opts.OutputDir, namespace.Name+".tf")
+		if err := os.WriteFile(file, g.file.Bytes(), 0o644); err != nil {
+			return files, fmt.Errorf("writing %s failed: %w", file, err)
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// readNamespaces reads the accounts of the namespaces, or all the orgs when none is given.
+func readNamespaces(ctx context.Context, c client.Client, names []string) ([]client.ResponseAccount, error) {
+	if len(names) == 0 {
+		orgs, err := c.ReadAccounts(ctx, client.Orgs)
+		if err != nil {
+			return nil, fmt.Errorf("reading the orgs failed: %w", err)
+		}
+		return orgs, nil
+	}
+
+	accounts := make([]client.ResponseAccount, 0, len(names))
+	for _, name := range names {
+		account, err := c.ReadAccount(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("reading the %s namespace failed: %w", name, err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// generator writes the configuration of one namespace.
+type generator struct {
+	ctx    context.Context
+	client client.Client
+	file   *hclwrite.File
+	// labels holds the resource addresses already written, to keep them unique
+	labels map[string]bool
+	empty  bool
+}
+
+func newGenerator(ctx context.Context, c client.Client, labels map[string]bool) *generator {
+	return &generator{
+		ctx:    ctx,
+		client: c,
+		file:   hclwrite.NewEmptyFile(),
+		labels: labels,
+		empty:  true,
+	}
+}
+
+// namespace writes the org with its teams, when the namespace is an org, and the repos with their pruning policies.
+func (g *generator) namespace(namespace client.ResponseAccount) error {
+	// The repos of a user namespace refer to it by name
+	namespaceRef := func(body *hclwrite.Body, name string) {
+		body.SetAttributeValue(name, cty.StringVal(namespace.Name))
+	}
+
+	if namespace.IsOrg {
+		body, org := g.resource("msr_org", namespace.Name, namespace.Name)
+		body.SetAttributeValue("name", cty.StringVal(namespace.Name))
+
+		namespaceRef = func(body *hclwrite.Body, name string) {
+			body.SetAttributeTraversal(name, org.attr("name"))
+		}
+
+		teams, err := g.client.ReadTeams(g.ctx, namespace.Name)
+		if err != nil {
+			return fmt.Errorf("reading the teams of %s failed: %w", namespace.Name, err)
+		}
+		for _, team := range teams {
+			if err := g.team(namespace.Name, team, namespaceRef); err != nil {
+				return err
+			}
+		}
+	}
+
+	repos, err := g.client.ReadRepos(g.ctx, namespace.Name)
+	if err != nil {
+		return fmt.Errorf("reading the repos of %s failed: %w", namespace.Name, err)
+	}
+	for _, repo := range repos {
+		if err := g.repo(repo, namespaceRef); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// team writes the team with its members, or its LDAP sync when the members are synced.
+func (g *generator) team(orgName string, team client.Team, orgRef func(*hclwrite.Body, string)) error {
+	body, _ := g.resource("msr_team", orgName+"_"+team.Name, orgName+"/"+team.Name)
+	body.SetAttributeValue("name", cty.StringVal(team.Name))
+	orgRef(body, "org_id")
+	if team.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(team.Description))
+	}
+
+	opts, err := g.client.ReadTeamMemberSyncConfig(g.ctx, team.OrgID, team.ID)
+	if err != nil {
+		return fmt.Errorf("reading the LDAP sync of the %s/%s team failed: %w", orgName, team.Name, err)
+	}
+	if opts.EnableSync {
+		sync := body.AppendNewBlock("ldap_sync", nil).Body()
+		if !opts.SelectGroupMembers {
+			sync.SetAttributeValue("sync_mode", cty.StringVal("search"))
+		}
+		setString(sync, "group_dn", opts.GroupDN)
+		setString(sync, "group_member_attr", opts.GroupMemberAttr)
+		setString(sync, "search_base_dn", opts.SearchBaseDN)
+		if opts.SearchScopeSubtree {
+			sync.SetAttributeValue("search_scope_subtree", cty.True)
+		}
+		setString(sync, "search_filter", opts.SearchFilter)
+
+		return nil
+	}
+
+	users, err := g.client.GetTeamUsers(g.ctx, team.OrgID, team.ID)
+	if err != nil {
+		return fmt.Errorf("reading the members of the %s/%s team failed: %w", orgName, team.Name, err)
+	}
+	if len(users.Members) > 0 {
+		ids := make([]cty.Value, 0, len(users.Members))
+		for _, m := range users.Members {
+			ids = append(ids, cty.StringVal(m.Member.ID))
+		}
+		body.SetAttributeValue("user_ids", cty.ListVal(ids))
+	}
+
+	return nil
+}
+
+// repo writes the repo and its pruning policies.
+func (g *generator) repo(repo client.ResponseRepo, namespaceRef func(*hclwrite.Body, string)) error {
+	body, address := g.resource("msr_repo", repo.Namespace+"_"+repo.Name, repo.Namespace+"/"+repo.Name)
+	body.SetAttributeValue("name", cty.StringVal(repo.Name))
+	namespaceRef(body, "org_name")
+	if repo.Visibility != "" && repo.Visibility != "private" {
+		body.SetAttributeValue("visibility", cty.StringVal(repo.Visibility))
+	}
+	if repo.ScanOnPush {
+		body.SetAttributeValue("scan_on_push", cty.True)
+	}
+	if repo.ImmutableTags {
+		body.SetAttributeValue("immutable_tags", cty.True)
+	}
+
+	policies, err := g.client.ReadPruningPolicies(g.ctx, repo.Namespace, repo.Name)
+	if err != nil {
+		return fmt.Errorf("reading the pruning policies of %s/%s failed: %w", repo.Namespace, repo.Name, err)
+	}
+	for _, policy := range policies {
+		importID := repo.Namespace + "/" + repo.Name + "/" + policy.ID
+		body, _ := g.resource("msr_pruning_policy", repo.Namespace+"_"+repo.Name+"_"+policy.ID, importID)
+		body.SetAttributeTraversal("org_name", address.attr("org_name"))
+		body.SetAttributeTraversal("repo_name", address.attr("name"))
+		if !policy.Enabled {
+			body.SetAttributeValue("enabled", cty.False)
+		}
+		for _, rule := range policy.Rules {
+			values := make([]cty.Value, 0, len(rule.Values))
+			for _, v := range rule.Values {
+				values = append(values, cty.StringVal(v))
+			}
+			ruleBody := body.AppendNewBlock("rule", nil).Body()
+			ruleBody.SetAttributeValue("field", cty.StringVal(rule.Field))
+			ruleBody.SetAttributeValue("operator", cty.StringVal(rule.Operator))
+			if len(values) == 0 {
+				ruleBody.SetAttributeValue("values", cty.ListValEmpty(cty.String))
+			} else {
+				ruleBody.SetAttributeValue("values", cty.ListVal(values))
+			}
+		}
+	}
+
+	return nil
+}
+
+// resource appends the import block and the resource block, returning the body of the resource.
+func (g *generator) resource(resourceType string, name string, importID string) (*hclwrite.Body, address) {
+	addr := address{resourceType: resourceType, label: g.label(resourceType, name)}
+
+	root := g.file.Body()
+	if !g.empty {
+		root.AppendNewline()
+	}
+	g.empty = false
+
+	imp := root.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", addr.traversal())
+	imp.SetAttributeValue("id", cty.StringVal(importID))
+	root.AppendNewline()
+
+	return root.AppendNewBlock("resource", []string{resourceType, addr.label}).Body(), addr
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// label turns the name into a resource name, suffixed with a number when it is already used.
+func (g *generator) label(resourceType string, name string) string {
+	label := invalidLabelChars.ReplaceAllString(name, "_")
+	// Resource names start with a letter or an underscore
+	if label == "" || !strings.ContainsAny(label[:1], "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_") {
+		label = "_" + label
+	}
+
+	unique := label
+	for i := 2; g.labels[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	g.labels[resourceType+"."+unique] = true
+
+	return unique
+}
+
+// address of a written resource.
+type address struct {
+	resourceType string
+	label        string
+}
+
+func (a address) traversal() hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: a.resourceType},
+		hcl.TraverseAttr{Name: a.label},
+	}
+}
+
+// attr refers to an attribute of the resource.
+func (a address) attr(name string) hcl.Traversal {
+	return append(a.traversal(), hcl.TraverseAttr{Name: name})
+}
+
+// setString sets the attribute when the value isn't empty, as an unset optional attribute.
+func setString(body *hclwrite.Body, name string, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
@@ -0,0 +1,238 @@
+package export_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/Mirantis/terraform-provider-msr/internal/export"
+)
+
+const expectedAcme = `import {
+  to = msr_org.acme
+  id = "acme"
+}
+
+resource "msr_org" "acme" {
+  name = "acme"
+}
+
+import {
+  to = msr_team.acme_devs
+  id = "acme/devs"
+}
+
+resource "msr_team" "acme_devs" {
+  name        = "devs"
+  org_id      = msr_org.acme.name
+  description = "developers"
+  user_ids    = ["u-1"]
+}
+
+import {
+  to = msr_team.acme_ldap_users
+  id = "acme/ldap users"
+}
+
+resource "msr_team" "acme_ldap_users" {
+  name   = "ldap users"
+  org_id = msr_org.acme.name
+  ldap_sync {
+    sync_mode      = "search"
+    search_base_dn = "dc=example,dc=com"
+    search_filter  = "(objectClass=person)"
+  }
+}
+
+import {
+  to = msr_repo.acme_app
+  id = "acme/app"
+}
+
+resource "msr_repo" "acme_app" {
+  name     = "app"
+  org_name = msr_org.acme.name
+}
+
+import {
+  to = msr_pruning_policy.acme_app_p-1
+  id = "acme/app/p-1"
+}
+
+resource "msr_pruning_policy" "acme_app_p-1" {
+  org_name  = msr_repo.acme_app.org_name
+  repo_name = msr_repo.acme_app.name
+  enabled   = false
+  rule {
+    field    = "tag"
+    operator = "matches"
+    values   = ["^dev-"]
+  }
+}
+
+import {
+  to = msr_repo.acme_lib
+  id = "acme/lib"
+}
+
+resource "msr_repo" "acme_lib" {
+  name           = "lib"
+  org_name       = msr_org.acme.name
+  visibility     = "public"
+  scan_on_push   = true
+  immutable_tags = true
+}
+`
+
+const expectedAlice = `import {
+  to = msr_repo.alice_tools
+  id = "alice/tools"
+}
+
+resource "msr_repo" "alice_tools" {
+  name     = "tools"
+  org_name = "alice"
+}
+`
+
+// newFakeMSR serves the org acme, with two teams and two repos, and the users alice, owning a repo, and bob.
+func newFakeMSR(t *testing.T) *httptest.Server {
+	org := client.ResponseAccount{ID: "o-1", Name: "acme", IsOrg: true}
+	alice := client.ResponseAccount{ID: "u-1", Name: "alice"}
+	bob := client.ResponseAccount{ID: "u-2", Name: "bob"}
+	repos := map[string][]client.ResponseRepo{
+		"acme": {
+			{ID: "r-1", Name: "app", Namespace: "acme", Visibility: "private"},
+			{ID: "r-2", Name: "lib", Namespace: "acme", Visibility: "public", ScanOnPush: true, ImmutableTags: true},
+		},
+		"alice": {{ID: "r-3", Name: "tools", Namespace: "alice", Visibility: "private"}},
+	}
+
+	reply := func(w http.ResponseWriter, v any) {
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Error(err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /enzi/v0/accounts", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("filter") != string(client.Orgs) {
+			t.Errorf("unexpected filter %s", r.URL.Query().Get("filter"))
+		}
+		reply(w, map[string][]client.ResponseAccount{"accounts": {org}})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{account}", func(w http.ResponseWriter, r *http.Request) {
+		for _, acc := range []client.ResponseAccount{org, alice, bob} {
+			if acc.Name == r.PathValue("account") {
+				reply(w, acc)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		reply(w, client.ResponseError{Errors: []client.Errors{{Message: "not found"}}})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/acme/teams", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string][]client.Team{"teams": {
+			{ID: "t-1", Name: "devs", OrgID: "o-1", Description: "developers"},
+			{ID: "t-2", Name: "ldap users", OrgID: "o-1"},
+		}})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/o-1/teams/{team}/memberSyncConfig", func(w http.ResponseWriter, r *http.Request) {
+		opts := client.MemberSyncOpts{}
+		if r.PathValue("team") == "t-2" {
+			opts = client.MemberSyncOpts{EnableSync: true, SearchBaseDN: "dc=example,dc=com", SearchFilter: "(objectClass=person)"}
+		}
+		reply(w, opts)
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/o-1/teams/t-1/members", func(w http.ResponseWriter, r *http.Request) {
+		members := client.TeamUsers{}
+		if err := json.Unmarshal([]byte(`{"members":[{"member":{"id":"u-1","name":"alice"}}]}`), &members); err != nil {
+			t.Error(err)
+		}
+		reply(w, members)
+	})
+	mux.HandleFunc("GET /api/v0/repositories/{namespace}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string][]client.ResponseRepo{"repositories": repos[r.PathValue("namespace")]})
+	})
+	mux.HandleFunc("GET /api/v0/repositories/{namespace}/{repo}/pruningPolicies", func(w http.ResponseWriter, r *http.Request) {
+		policies := []client.ResponsePruningPolicy{}
+		if r.PathValue("repo") == "app" {
+			policies = append(policies, client.ResponsePruningPolicy{
+				ID:    "p-1",
+				Rules: []client.PruningPolicyRuleAPI{{Field: "tag", Operator: "matches", Values: []string{"^dev-"}}},
+			})
+		}
+		reply(w, policies)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRun(t *testing.T) {
+	server := newFakeMSR(t)
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", false)
+	if err != nil {
+		t.Fatal("couldn't create test client")
+	}
+
+	testCases := map[string]struct {
+		namespaces []string
+		expected   map[string]string
+	}{
+		"all the orgs": {
+			expected: map[string]string{"acme.tf": expectedAcme},
+		},
+		// bob has no repo, no file is written for him
+		"namespaces": {
+			namespaces: []string{"acme", "alice", "bob"},
+			expected:   map[string]string{"acme.tf": expectedAcme, "alice.tf": expectedAlice},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			files, err := export.Run(context.Background(), testClient, export.Options{Namespaces: tc.namespaces, OutputDir: dir})
+			if err != nil {
+				t.Fatalf("expected no error, got (%v)", err)
+			}
+
+			written := map[string]string{}
+			for _, file := range files {
+				content, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				written[filepath.Base(file)] = string(content)
+			}
+			if !reflect.DeepEqual(tc.expected, written) {
+				t.Errorf("expected files: (%+v),\n got (%+v)", tc.expected, written)
+			}
+		})
+	}
+}
+
+func TestRunUnknownNamespace(t *testing.T) {
+	server := newFakeMSR(t)
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass", false)
+	if err != nil {
+		t.Fatal("couldn't create test client")
+	}
+
+	files, err := export.Run(context.Background(), testClient, export.Options{Namespaces: []string{"nobody"}, OutputDir: t.TempDir()})
+	if err == nil {
+		t.Error("expected an error for the unknown namespace")
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no file, got (%v)", files)
+	}
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &AccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &AccessTokenEphemeralResource{}
+)
+
+// accessTokenPrivateKey is the private data key holding the hash of the token to revoke on Close.
+const accessTokenPrivateKey = "hashed_token"
+
+type AccessTokenEphemeralResourceModel struct {
+	Username    types.String `tfsdk:"username"`
+	Description types.String `tfsdk:"description"`
+	HashedToken types.String `tfsdk:"hashed_token"`
+	Token       types.String `tfsdk:"token"`
+}
+
+// AccessTokenEphemeralResource creates short-lived access tokens, revoked as soon as Terraform is done with them.
+type AccessTokenEphemeralResource struct {
+	client client.Client
+}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Short-lived personal access token of a user. " +
+			"The token is created when Terraform needs it and revoked when Terraform is done, it is never stored in the state",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name of the user owning the token",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the token",
+				Optional:            true,
+			},
+			"hashed_token": schema.StringAttribute{
+				MarkdownDescription: "The hash identifying the token in MSR",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The access token, use it as the password of the user",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data *AccessTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr access token ephemeral resource handler is in testing mode, no creation or revocation will be run.")
+		data.HashedToken = types.StringValue(TestingVersion)
+		data.Token = types.StringValue(TestingVersion)
+	} else {
+		token, err := r.client.CreateAccessToken(ctx, data.Username.ValueString(), data.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("created access token `%s` for `%s`", token.HashedToken, data.Username.ValueString()))
+
+		data.HashedToken = types.StringValue(token.HashedToken)
+		data.Token = types.StringValue(token.Token)
+
+		hashed, err := json.Marshal(token.HashedToken)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessTokenPrivateKey, hashed)...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *AccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	hashed, diags := req.Private.GetKey(ctx, accessTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	// Nothing was created, e.g. in testing mode
+	if resp.Diagnostics.HasError() || hashed == nil {
+		return
+	}
+
+	var hashedToken string
+	if err := json.Unmarshal(hashed, &hashedToken); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	if err := r.client.DeleteAccessToken(ctx, hashedToken); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Revoked access token", map[string]any{"success": true})
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &AccessTokenResource{}
+	_ resource.ResourceWithImportState = &AccessTokenResource{}
+	_ resource.ResourceWithIdentity    = &AccessTokenResource{}
+)
+
+type AccessTokenResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Username    types.String   `tfsdk:"username"`
+	Description types.String   `tfsdk:"description"`
+	Token       types.String   `tfsdk:"token"`
+	Created     types.String   `tfsdk:"created"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AccessTokenResourceIdentityModel identifies a token by its user and hash.
+type AccessTokenResourceIdentityModel struct {
+	Username    types.String `tfsdk:"username"`
+	HashedToken types.String `tfsdk:"hashed_token"`
+}
+
+type AccessTokenResource struct {
+	client client.Client
+}
+
+func NewAccessTokenResource() resource.Resource {
+	return &AccessTokenResource{}
+}
+
+func (r *AccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Long-lived personal access token of a user. " +
+			"MSR only returns the token when it's created, it is stored in the state and can't be recovered on import. " +
+			"Use the `msr_access_token` ephemeral resource for short-lived tokens",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hash identifying the token in MSR",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name of the user owning the token",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the token",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The access token, use it as the password of the user",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The creation time of the token",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessTokenResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"username": identityschema.StringAttribute{
+				Description:       "The name of the user owning the token",
+				RequiredForImport: true,
+			},
+			"hashed_token": identityschema.StringAttribute{
+				Description:       "The hash identifying the token in MSR",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AccessTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr access token resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+		data.Token = basetypes.NewStringValue(TestingVersion)
+		data.Created = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rToken, err := r.client.CreateAccessToken(ctx, data.Username.ValueString(), data.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create access token error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("created access token `%s` for `%s`", rToken.HashedToken, data.Username.ValueString()))
+		data.Id = basetypes.NewStringValue(rToken.HashedToken)
+		data.Token = basetypes.NewStringValue(rToken.Token)
+		data.Description = basetypes.NewStringValue(rToken.TokenLabel)
+		data.Created = basetypes.NewStringValue(rToken.CreationTime)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *AccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read access token resource")
+	var data *AccessTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr access token resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+		data.Created = types.StringValue(TestingVersion)
+	} else {
+		rToken, err := r.client.ReadAccessToken(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		// The token itself is only returned on creation, keep the one in the state
+		data.Description = types.StringValue(rToken.TokenLabel)
+		data.Created = types.StringValue(rToken.CreationTime)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *AccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update access token resource")
+
+	var data *AccessTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr access token resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rToken, err := r.client.UpdateAccessToken(ctx, data.Id.ValueString(), client.UpdateAccessToken{TokenLabel: data.Description.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.Description = types.StringValue(rToken.TokenLabel)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'access token' resource", map[string]any{"success": true})
+}
+
+func (r *AccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AccessTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr access token resource handler is in testing mode, no deletion will be run.")
+	} else if err := r.client.DeleteAccessToken(ctx, data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted access token resource", map[string]any{"success": true})
+}
+
+func (r *AccessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity AccessTokenResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), identity.Username)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.HashedToken)...)
+		return
+	}
+
+	idParts := splitImportID(req.ID)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.Append(importIDError("username/hashed_token", req.ID)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// identity returns the identity of the token.
+func (m *AccessTokenResourceModel) identity() AccessTokenResourceIdentityModel {
+	return AccessTokenResourceIdentityModel{
+		Username:    m.Username,
+		HashedToken: m.Id,
+	}
+}
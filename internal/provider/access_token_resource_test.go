@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccessTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Import needs the user and the token hash
+			{
+				Config:        providerConfig + testAccessTokenResource("ci"),
+				ResourceName:  "msr_access_token.test",
+				ImportState:   true,
+				ImportStateId: TestingVersion,
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccessTokenResource("ci"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_access_token.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_access_token.test", "username", "ci-bot"),
+					resource.TestCheckResourceAttr("msr_access_token.test", "description", "ci"),
+					resource.TestCheckResourceAttr("msr_access_token.test", "token", TestingVersion),
+				),
+			},
+			// ImportState testing, the token can't be recovered
+			{
+				ResourceName:            "msr_access_token.test",
+				ImportState:             true,
+				ImportStateId:           "ci-bot/" + TestingVersion,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "description"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccessTokenResource("deploy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_access_token.test", "description", "deploy"),
+					resource.TestCheckResourceAttr("msr_access_token.test", "token", TestingVersion),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testAccessTokenResource(description string) string {
+	return `
+	resource "msr_access_token" "test" {
+		username    = "ci-bot"
+		description = "` + description + `"
+	}`
+}
@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
+	"regexp"
+	"strings"
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -29,9 +32,18 @@ type accountsDataSource struct {
 
 // accountsDataSourceModel maps the data source schema data.
 type accountsDataSourceModel struct {
-	ID       types.String             `tfsdk:"id"`
-	Filter   types.String             `tfsdk:"filter"`
-	Accounts []accountDataSourceModel `tfsdk:"accounts"`
+	ID         types.String             `tfsdk:"id"`
+	Filter     types.String             `tfsdk:"filter"`
+	NamePrefix types.String             `tfsdk:"name_prefix"`
+	NameRegex  types.String             `tfsdk:"name_regex"`
+	IsOrg      types.Bool               `tfsdk:"is_org"`
+	IsAdmin    types.Bool               `tfsdk:"is_admin"`
+	IsActive   types.Bool               `tfsdk:"is_active"`
+	IsImported types.Bool               `tfsdk:"is_imported"`
+	Org        types.String             `tfsdk:"org"`
+	Team       types.String             `tfsdk:"team"`
+	Limit      types.Int64              `tfsdk:"limit"`
+	Accounts   []accountDataSourceModel `tfsdk:"accounts"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -56,7 +68,8 @@ func (d *accountsDataSource) Metadata(ctx context.Context, req datasource.Metada
 func (d *accountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Accounts data source",
+		MarkdownDescription: "Accounts data source. The `filter` and the `is_*` flags are applied by MSR when possible, " +
+			"the remaining criteria narrow down the results in the provider",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -64,10 +77,49 @@ func (d *accountsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "Identifier",
 			},
 			"filter": schema.StringAttribute{
-				MarkdownDescription: "The name of the account",
+				MarkdownDescription: "The MSR account filter, one of `all`, `users`, `orgs`, `admins`, `non-admins`, `active-users` or `inactive-users`",
 				Optional:            true,
 				Computed:            true,
-				Validators:          []validator.String{stringvalidator.OneOf("users", "orgs", "admins", "non-admins", "active-users", "all")},
+				Validators:          []validator.String{stringvalidator.OneOf(accountFilters()...)},
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve the accounts whose name starts with the prefix",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve the accounts whose name matches the regular expression",
+				Optional:            true,
+				Validators:          []validator.String{regexValidator{}},
+			},
+			"is_org": schema.BoolAttribute{
+				MarkdownDescription: "Only retrieve organizations when true, or users when false",
+				Optional:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Only retrieve the accounts with this admin flag",
+				Optional:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Only retrieve the accounts with this active flag",
+				Optional:            true,
+			},
+			"is_imported": schema.BoolAttribute{
+				MarkdownDescription: "Only retrieve the accounts with this imported flag",
+				Optional:            true,
+			},
+			"org": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve the members of the organization",
+				Optional:            true,
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve the members of the team of `org`",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("org"))},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of accounts retrieved",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
 			},
 		},
 
@@ -148,13 +200,42 @@ func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.Filter.ValueString(),
+		data.NamePrefix.ValueString(),
+		data.NameRegex.ValueString(),
+		data.IsOrg.String(),
+		data.IsAdmin.String(),
+		data.IsActive.String(),
+		data.IsImported.String(),
+		data.Org.ValueString(),
+		data.Team.ValueString(),
+		data.Limit.String(),
+	))
+	filter := data.serverFilter()
+	data.Filter = basetypes.NewStringValue(string(filter))
+
 	if d.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr account datasource handler is in testing mode, no injestion will be run.")
-		data.ID = basetypes.NewStringValue(TestingVersion)
 	} else {
-		filter := client.AccountFilter(data.Filter.ValueString())
-
-		rAccs, err := d.client.ReadAccounts(ctx, filter)
+		var rAccs []client.ResponseAccount
+		var err error
+		switch {
+		case !data.Team.IsNull():
+			var tUsers client.TeamUsers
+			tUsers, err = d.client.GetTeamUsers(ctx, data.Org.ValueString(), data.Team.ValueString())
+			for _, m := range tUsers.Members {
+				rAccs = append(rAccs, m.Member)
+			}
+		case !data.Org.IsNull():
+			rAccs, err = d.client.ReadOrgMembers(ctx, data.Org.ValueString())
+		default:
+			rAccs, err = d.client.ReadAccounts(ctx, filter)
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Accounts",
@@ -163,9 +244,20 @@ func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			return
 		}
 
+		var nameRegex *regexp.Regexp
+		if !data.NameRegex.IsNull() {
+			nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+		}
+
 		var accs []accountDataSourceModel
 
 		for _, u := range rAccs {
+			if !filter.Matches(u) || !data.matches(u, nameRegex) {
+				continue
+			}
+			if !data.Limit.IsNull() && int64(len(accs)) >= data.Limit.ValueInt64() {
+				break
+			}
 			acc := accountDataSourceModel{
 				ID:           basetypes.NewStringValue(u.ID),
 				NameOrID:     basetypes.NewStringValue(u.ID),
@@ -174,6 +266,9 @@ func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 				IsActive:     basetypes.NewBoolValue(u.IsActive),
 				IsAdmin:      basetypes.NewBoolValue(u.IsAdmin),
 				IsOrg:        basetypes.NewBoolValue(u.IsOrg),
+				IsImported:   basetypes.NewBoolValue(u.IsImported),
+				OnDemand:     basetypes.NewBoolValue(u.OnDemand),
+				OtpEnabled:   basetypes.NewBoolValue(u.OtpEnabled),
 				MembersCount: basetypes.NewInt64Value(int64(u.MembersCount)),
 				TeamsCount:   basetypes.NewInt64Value(int64(u.TeamsCount)),
 			}
@@ -181,12 +276,67 @@ func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		}
 
 		data.Accounts = accs
-		data.ID = basetypes.NewStringValue(time.Now().Format(time.RFC850))
 
-		tflog.Trace(ctx, fmt.Sprintf("read in accounts data source `%s`", data.ID))
+		tflog.Trace(ctx, fmt.Sprintf("read %d accounts in accounts data source", len(accs)))
 	}
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Debug(ctx, "Finished reading accounts data source", map[string]any{"success": true})
 }
+
+// accountFilters lists the values accepted for the filter attribute.
+func accountFilters() []string {
+	filters := []string{}
+	for _, f := range client.AccountFilters {
+		filters = append(filters, string(f))
+	}
+	return filters
+}
+
+// serverFilter returns the configured filter or, when there is none,
+// the MSR filter narrowing down the accounts the most given the flags.
+func (m *accountsDataSourceModel) serverFilter() client.AccountFilter {
+	switch {
+	case !m.Filter.IsNull():
+		return client.AccountFilter(m.Filter.ValueString())
+	case m.IsOrg.ValueBool():
+		return client.Orgs
+	case !m.IsAdmin.IsNull() && m.IsAdmin.ValueBool():
+		return client.Admins
+	case !m.IsAdmin.IsNull():
+		return client.NonAdmins
+	case !m.IsActive.IsNull() && m.IsActive.ValueBool():
+		return client.ActiveUsers
+	case !m.IsActive.IsNull():
+		return client.InactiveUsers
+	case !m.IsOrg.IsNull():
+		return client.Users
+	default:
+		return client.AllAccounts
+	}
+}
+
+// matches reports whether the account meets the criteria MSR can't filter on.
+func (m *accountsDataSourceModel) matches(acc client.ResponseAccount, nameRegex *regexp.Regexp) bool {
+	if !strings.HasPrefix(acc.Name, m.NamePrefix.ValueString()) {
+		return false
+	}
+	if nameRegex != nil && !nameRegex.MatchString(acc.Name) {
+		return false
+	}
+	for _, flag := range []struct {
+		want  types.Bool
+		value bool
+	}{
+		{m.IsOrg, acc.IsOrg},
+		{m.IsAdmin, acc.IsAdmin},
+		{m.IsActive, acc.IsActive},
+		{m.IsImported, acc.IsImported},
+	} {
+		if !flag.want.IsNull() && flag.want.ValueBool() != flag.value {
+			return false
+		}
+	}
+	return true
+}
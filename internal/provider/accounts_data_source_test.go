@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -10,6 +11,30 @@ func TestAccountsDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// The filter must be one accepted by MSR
+			{
+				Config: providerConfig + `
+				data "msr_accounts" "test" {
+					filter = "user"
+				}`,
+				ExpectError: regexp.MustCompile("filter"),
+			},
+			// The name regex must compile
+			{
+				Config: providerConfig + `
+				data "msr_accounts" "test" {
+					name_regex = "dev("
+				}`,
+				ExpectError: regexp.MustCompile("Invalid regular expression"),
+			},
+			// The team is looked up within the org
+			{
+				Config: providerConfig + `
+				data "msr_accounts" "test" {
+					team = "developers"
+				}`,
+				ExpectError: regexp.MustCompile("org"),
+			},
 			{
 				Config: providerConfig + testMSRaccountsDefault(),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -46,6 +71,21 @@ func TestAccountsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.msr_accounts.test", "id"),
 				),
 			},
+			{
+				Config: providerConfig + `
+				data "msr_accounts" "test" {
+					name_prefix = "dev"
+					is_active   = false
+					org         = "example"
+					limit       = 10
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_accounts.test", "filter", "inactive-users"),
+					// Verify the id is derived from the query
+					resource.TestCheckResourceAttr("data.msr_accounts.test", "id",
+						queryID("", "dev", "", "<null>", "<null>", "false", "<null>", "example", "", "10")),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource             = &AuthOIDCResource{}
+	_ resource.ResourceWithIdentity = &AuthOIDCResource{}
+)
+
+// authOIDCID is the identifier of the single OIDC configuration of MSR.
+const authOIDCID = "oidc"
+
+type AuthOIDCResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	IssuerURL     types.String   `tfsdk:"issuer_url"`
+	ClientID      types.String   `tfsdk:"client_id"`
+	ClientSecret  types.String   `tfsdk:"client_secret"`
+	Scopes        []types.String `tfsdk:"scopes"`
+	UsernameClaim types.String   `tfsdk:"username_claim"`
+	GroupsClaim   types.String   `tfsdk:"groups_claim"`
+	RootCerts     types.String   `tfsdk:"root_certs"`
+	TLSSkipVerify types.Bool     `tfsdk:"tls_skip_verify"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AuthOIDCResourceIdentityModel identifies the single OIDC configuration of MSR.
+type AuthOIDCResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type AuthOIDCResource struct {
+	client client.Client
+}
+
+func NewAuthOIDCResource() resource.Resource {
+	return &AuthOIDCResource{}
+}
+
+func (r *AuthOIDCResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_oidc"
+}
+
+func (r *AuthOIDCResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "OpenID Connect single sign-on settings resource, for the MSR versions supporting it. " +
+			"There is a single OIDC configuration per MSR instance",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Allow the users to log in through the identity provider",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"issuer_url": schema.StringAttribute{
+				MarkdownDescription: "The HTTPS URL of the identity provider, serving the `.well-known/openid-configuration` discovery document",
+				Required:            true,
+				Validators:          []validator.String{httpsURLValidator{}},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client identifier of MSR at the identity provider",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of MSR at the identity provider",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "The scopes requested besides `openid`, e.g. `email` or `groups`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"username_claim": schema.StringAttribute{
+				MarkdownDescription: "The claim used as MSR user name",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sub"),
+			},
+			"groups_claim": schema.StringAttribute{
+				MarkdownDescription: "The claim listing the groups of the user",
+				Optional:            true,
+			},
+			"root_certs": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded root certificates trusted for the identity provider",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators:          []validator.String{pemCertificatesValidator{}},
+			},
+			"tls_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the identity provider certificate",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *AuthOIDCResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the OIDC configuration, always `oidc`",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AuthOIDCResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuthOIDCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AuthOIDCResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr auth oidc resource handler is in testing mode, no creation will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.UpdateOIDCSettings(ctx, data.toOIDCSettings())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create auth oidc error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("configured OIDC issuer `%s`", rSettings.IssuerURL))
+		data.fromOIDCSettings(rSettings)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *AuthOIDCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read auth oidc resource")
+	var data *AuthOIDCResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr auth oidc resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.ReadOIDCSettings(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromOIDCSettings(rSettings)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *AuthOIDCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update auth oidc resource")
+
+	var data *AuthOIDCResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr auth oidc resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.UpdateOIDCSettings(ctx, data.toOIDCSettings())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromOIDCSettings(rSettings)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'auth oidc' resource", map[string]any{"success": true})
+}
+
+func (r *AuthOIDCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Users may still log in through OIDC, the configuration is left in place.
+	tflog.Trace(ctx, "No action taken. auth oidc resource is removed from the state only.")
+}
+
+func (r *AuthOIDCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// identity returns the identity of the OIDC configuration.
+// There is a single OIDC configuration, the identity is the same in testing mode.
+func (m *AuthOIDCResourceModel) identity() AuthOIDCResourceIdentityModel {
+	return AuthOIDCResourceIdentityModel{
+		Id: types.StringValue(authOIDCID),
+	}
+}
+
+// toOIDCSettings converts the model to the enzi OIDC settings.
+func (m *AuthOIDCResourceModel) toOIDCSettings() client.OIDCSettings {
+	settings := client.OIDCSettings{
+		Enabled:       m.Enabled.ValueBool(),
+		IssuerURL:     m.IssuerURL.ValueString(),
+		ClientID:      m.ClientID.ValueString(),
+		ClientSecret:  m.ClientSecret.ValueString(),
+		Scopes:        []string{},
+		UsernameClaim: m.UsernameClaim.ValueString(),
+		GroupsClaim:   m.GroupsClaim.ValueString(),
+		RootCerts:     m.RootCerts.ValueString(),
+		TLSSkipVerify: m.TLSSkipVerify.ValueBool(),
+	}
+
+	for _, s := range m.Scopes {
+		settings.Scopes = append(settings.Scopes, s.ValueString())
+	}
+
+	return settings
+}
+
+// fromOIDCSettings refreshes the model from the enzi OIDC settings.
+// The client secret enzi doesn't return keeps its prior value.
+func (m *AuthOIDCResourceModel) fromOIDCSettings(settings client.OIDCSettings) {
+	m.Id = types.StringValue(authOIDCID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+	m.IssuerURL = types.StringValue(settings.IssuerURL)
+	m.ClientID = types.StringValue(settings.ClientID)
+	m.ClientSecret = secretString(m.ClientSecret, settings.ClientSecret)
+	m.UsernameClaim = types.StringValue(settings.UsernameClaim)
+	m.GroupsClaim = optionalString(m.GroupsClaim, settings.GroupsClaim)
+	m.RootCerts = types.StringValue(settings.RootCerts)
+	m.TLSSkipVerify = types.BoolValue(settings.TLSSkipVerify)
+
+	m.Scopes = nil
+	for _, s := range settings.Scopes {
+		m.Scopes = append(m.Scopes, types.StringValue(s))
+	}
+}
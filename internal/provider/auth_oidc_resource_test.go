@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAuthOIDCResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The issuer URL must use HTTPS
+			{
+				Config: providerConfig + `
+				resource "msr_auth_oidc" "test" {
+					issuer_url    = "http://idp.example.com"
+					client_id     = "msr"
+					client_secret = "secret"
+				}`,
+				ExpectError: regexp.MustCompile("https"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAuthOIDCResource("email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_auth_oidc.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_auth_oidc.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("msr_auth_oidc.test", "username_claim", "email"),
+					resource.TestCheckResourceAttr("msr_auth_oidc.test", "scopes.#", "2"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAuthOIDCResource("preferred_username"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_auth_oidc.test", "username_claim", "preferred_username"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testAuthOIDCResource(claim string) string {
+	return `
+	resource "msr_auth_oidc" "test" {
+		issuer_url     = "https://idp.example.com"
+		client_id      = "msr"
+		client_secret  = "secret"
+		scopes         = ["email", "groups"]
+		username_claim = "` + claim + `"
+		groups_claim   = "groups"
+	}`
+}
@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &AuthSAMLResource{}
+	_ resource.ResourceWithConfigValidators = &AuthSAMLResource{}
+	_ resource.ResourceWithIdentity         = &AuthSAMLResource{}
+)
+
+// authSAMLID is the identifier of the single SAML configuration of MSR.
+const authSAMLID = "saml"
+
+type AuthSAMLResourceModel struct {
+	Id             types.String       `tfsdk:"id"`
+	Enabled        types.Bool         `tfsdk:"enabled"`
+	IdPMetadataURL types.String       `tfsdk:"idp_metadata_url"`
+	IdPMetadataXML types.String       `tfsdk:"idp_metadata_xml"`
+	SPHost         types.String       `tfsdk:"sp_host"`
+	RootCerts      types.String       `tfsdk:"root_certs"`
+	TLSSkipVerify  types.Bool         `tfsdk:"tls_skip_verify"`
+	Mappings       []samlMappingModel `tfsdk:"mapping"`
+	Timeouts       timeouts.Value     `tfsdk:"timeouts"`
+}
+
+// AuthSAMLResourceIdentityModel identifies the single SAML configuration of MSR.
+type AuthSAMLResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type samlMappingModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Value     types.String `tfsdk:"value"`
+	Org       types.String `tfsdk:"org"`
+	Team      types.String `tfsdk:"team"`
+}
+
+type AuthSAMLResource struct {
+	client client.Client
+}
+
+func NewAuthSAMLResource() resource.Resource {
+	return &AuthSAMLResource{}
+}
+
+func (r *AuthSAMLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_saml"
+}
+
+func (r *AuthSAMLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SAML single sign-on settings resource. There is a single SAML configuration per MSR instance, " +
+			"the identity provider metadata is given either by URL or as XML",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Allow the users to log in through the identity provider",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"idp_metadata_url": schema.StringAttribute{
+				MarkdownDescription: "The HTTPS URL the identity provider metadata is fetched from",
+				Optional:            true,
+				Validators:          []validator.String{httpsURLValidator{}},
+			},
+			"idp_metadata_xml": schema.StringAttribute{
+				MarkdownDescription: "The identity provider metadata, as an `EntityDescriptor` XML document",
+				Optional:            true,
+				Validators:          []validator.String{samlMetadataValidator{}},
+			},
+			"sp_host": schema.StringAttribute{
+				MarkdownDescription: "The host name of MSR as the service provider, e.g. `msr.example.com`",
+				Required:            true,
+			},
+			"root_certs": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded root certificates trusted for the metadata URL",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators:          []validator.String{pemCertificatesValidator{}},
+			},
+			"tls_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the metadata URL certificate",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+			"mapping": schema.ListNestedBlock{
+				MarkdownDescription: "Rule adding the users to an organization, or one of its teams, based on an assertion attribute",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							MarkdownDescription: "The name of the assertion attribute, e.g. `groups`",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value the attribute must have",
+							Required:            true,
+						},
+						"org": schema.StringAttribute{
+							MarkdownDescription: "The organization the matching users are added to",
+							Required:            true,
+						},
+						"team": schema.StringAttribute{
+							MarkdownDescription: "The team of the organization the matching users are added to",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AuthSAMLResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the SAML configuration, always `saml`",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AuthSAMLResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("idp_metadata_url"),
+			path.MatchRoot("idp_metadata_xml"),
+		),
+	}
+}
+
+func (r *AuthSAMLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuthSAMLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AuthSAMLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr auth saml resource handler is in testing mode, no creation will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.UpdateSAMLSettings(ctx, data.toSAMLSettings())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create auth saml error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("configured SAML for service provider `%s`", rSettings.SPHost))
+		data.fromSAMLSettings(rSettings)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *AuthSAMLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read auth saml resource")
+	var data *AuthSAMLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr auth saml resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.ReadSAMLSettings(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromSAMLSettings(rSettings)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *AuthSAMLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update auth saml resource")
+
+	var data *AuthSAMLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr auth saml resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.UpdateSAMLSettings(ctx, data.toSAMLSettings())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromSAMLSettings(rSettings)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'auth saml' resource", map[string]any{"success": true})
+}
+
+func (r *AuthSAMLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Users may still log in through SAML, the configuration is left in place.
+	tflog.Trace(ctx, "No action taken. auth saml resource is removed from the state only.")
+}
+
+func (r *AuthSAMLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// identity returns the identity of the SAML configuration.
+// There is a single SAML configuration, the identity is the same in testing mode.
+func (m *AuthSAMLResourceModel) identity() AuthSAMLResourceIdentityModel {
+	return AuthSAMLResourceIdentityModel{
+		Id: types.StringValue(authSAMLID),
+	}
+}
+
+// toSAMLSettings converts the model to the enzi SAML settings.
+func (m *AuthSAMLResourceModel) toSAMLSettings() client.SAMLSettings {
+	settings := client.SAMLSettings{
+		Enabled:        m.Enabled.ValueBool(),
+		IdPMetadataURL: m.IdPMetadataURL.ValueString(),
+		IdPMetadata:    m.IdPMetadataXML.ValueString(),
+		SPHost:         m.SPHost.ValueString(),
+		RootCerts:      m.RootCerts.ValueString(),
+		TLSSkipVerify:  m.TLSSkipVerify.ValueBool(),
+		Mappings:       []client.SAMLMapping{},
+	}
+
+	for _, mapping := range m.Mappings {
+		settings.Mappings = append(settings.Mappings, client.SAMLMapping{
+			Attribute: mapping.Attribute.ValueString(),
+			Value:     mapping.Value.ValueString(),
+			Org:       mapping.Org.ValueString(),
+			Team:      mapping.Team.ValueString(),
+		})
+	}
+
+	return settings
+}
+
+// fromSAMLSettings refreshes the model from the enzi SAML settings.
+func (m *AuthSAMLResourceModel) fromSAMLSettings(settings client.SAMLSettings) {
+	m.Id = types.StringValue(authSAMLID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+	m.IdPMetadataURL = optionalString(m.IdPMetadataURL, settings.IdPMetadataURL)
+	m.IdPMetadataXML = optionalString(m.IdPMetadataXML, settings.IdPMetadata)
+	m.SPHost = types.StringValue(settings.SPHost)
+	m.RootCerts = types.StringValue(settings.RootCerts)
+	m.TLSSkipVerify = types.BoolValue(settings.TLSSkipVerify)
+
+	priorMappings := m.Mappings
+	m.Mappings = nil
+	for i, mapping := range settings.Mappings {
+		p := samlMappingModel{}
+		if i < len(priorMappings) {
+			p = priorMappings[i]
+		}
+		m.Mappings = append(m.Mappings, samlMappingModel{
+			Attribute: types.StringValue(mapping.Attribute),
+			Value:     types.StringValue(mapping.Value),
+			Org:       types.StringValue(mapping.Org),
+			Team:      optionalString(p.Team, mapping.Team),
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAuthSAMLResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The metadata URL must use HTTPS
+			{
+				Config: providerConfig + `
+				resource "msr_auth_saml" "test" {
+					idp_metadata_url = "http://idp.example.com/metadata"
+					sp_host          = "msr.example.com"
+				}`,
+				ExpectError: regexp.MustCompile("https"),
+			},
+			// The root certificates must be PEM encoded
+			{
+				Config: providerConfig + `
+				resource "msr_auth_saml" "test" {
+					idp_metadata_url = "https://idp.example.com/metadata"
+					sp_host          = "msr.example.com"
+					root_certs       = "not a certificate"
+				}`,
+				ExpectError: regexp.MustCompile("PEM"),
+			},
+			// Either the metadata URL or XML is required
+			{
+				Config: providerConfig + `
+				resource "msr_auth_saml" "test" {
+					sp_host = "msr.example.com"
+				}`,
+				ExpectError: regexp.MustCompile("idp_metadata"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAuthSAMLResource("devs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "sp_host", "msr.example.com"),
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "tls_skip_verify", "false"),
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "mapping.#", "1"),
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "mapping.0.team", "devs"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAuthSAMLResource("ops"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_auth_saml.test", "mapping.0.team", "ops"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testAuthSAMLResource(team string) string {
+	return `
+	resource "msr_auth_saml" "test" {
+		idp_metadata_url = "https://idp.example.com/metadata"
+		sp_host          = "msr.example.com"
+
+		mapping {
+			attribute = "groups"
+			value     = "developers"
+			org       = "example"
+			team      = "` + team + `"
+		}
+	}`
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &cronsDataSource{}
+)
+
+func NewCronsDataSource() datasource.DataSource {
+	return &cronsDataSource{}
+}
+
+type cronsDataSource struct {
+	client client.Client
+}
+
+// cronsDataSourceModel maps the data source schema data.
+type cronsDataSourceModel struct {
+	ID     types.String    `tfsdk:"id"`
+	Action types.String    `tfsdk:"action"`
+	Crons  []cronDataModel `tfsdk:"crons"`
+}
+
+// cronDataModel maps a single cron.
+type cronDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Action      types.String `tfsdk:"action"`
+	Schedule    types.String `tfsdk:"schedule"`
+	Retries     types.Int64  `tfsdk:"retries"`
+	Deadline    types.String `tfsdk:"deadline"`
+	StopTimeout types.String `tfsdk:"stop_timeout"`
+	NextRun     types.String `tfsdk:"next_run"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cronsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *cronsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crons"
+}
+
+func (d *cronsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Crons data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve the cron of this action, e.g. `onlinegc`",
+				Optional:            true,
+			},
+			"crons": schema.ListNestedAttribute{
+				MarkdownDescription: "The crons retrieved from MSR",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The action scheduled by the cron",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							MarkdownDescription: "The cron expression of the schedule",
+							Computed:            true,
+						},
+						"retries": schema.Int64Attribute{
+							MarkdownDescription: "The number of retries of the scheduled jobs",
+							Computed:            true,
+						},
+						"deadline": schema.StringAttribute{
+							MarkdownDescription: "How long the scheduled jobs may run",
+							Computed:            true,
+						},
+						"stop_timeout": schema.StringAttribute{
+							MarkdownDescription: "How long the scheduled jobs are given to stop once past their deadline",
+							Computed:            true,
+						},
+						"next_run": schema.StringAttribute{
+							MarkdownDescription: "The time of the next scheduled job",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *cronsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read crons data source")
+	var data cronsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(data.Action.ValueString()))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr crons datasource handler is in testing mode, no injestion will be run.")
+		data.Crons = []cronDataModel{}
+	} else {
+		rCrons, err := d.client.ReadCrons(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Crons",
+				err.Error(),
+			)
+			return
+		}
+
+		crons := []cronDataModel{}
+		for _, c := range rCrons {
+			if !data.Action.IsNull() && c.Action != data.Action.ValueString() {
+				continue
+			}
+			crons = append(crons, cronDataModel{
+				ID:          basetypes.NewStringValue(c.ID),
+				Action:      basetypes.NewStringValue(c.Action),
+				Schedule:    basetypes.NewStringValue(c.Schedule),
+				Retries:     basetypes.NewInt64Value(int64(c.Retries)),
+				Deadline:    basetypes.NewStringValue(c.Deadline),
+				StopTimeout: basetypes.NewStringValue(c.StopTimeout),
+				NextRun:     basetypes.NewStringValue(c.NextRun),
+			})
+		}
+		data.Crons = crons
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d crons in crons data source", len(crons)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading crons data source", map[string]any{"success": true})
+}
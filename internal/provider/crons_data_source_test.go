@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCronsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testMSRcronsDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_crons.test", "action", "onlinegc"),
+					resource.TestCheckResourceAttr("data.msr_crons.test", "crons.#", "0"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.msr_crons.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testMSRcronsDefault() string {
+	return `
+	data "msr_crons" "test" {
+		action = "onlinegc"
+	}
+	`
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTestMSRServer starts a fake MSR holding the objects the import and list tests read back.
+// Accounts and teams are found by name or by ID, like enzi does.
+func newTestMSRServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	user := client.ResponseAccount{ID: "u-1", Name: "alice", FullName: "Alice", IsActive: true}
+	admin := client.ResponseAccount{ID: "u-2", Name: "admin", IsActive: true, IsAdmin: true}
+	org := client.ResponseAccount{ID: "o-1", Name: "acme", IsActive: true, IsOrg: true}
+	team := client.Team{ID: "t-1", Name: "devs", OrgID: org.ID, Description: "developers"}
+	ops := client.Team{ID: "t-2", Name: "ops", OrgID: org.ID}
+	repo := client.ResponseRepo{ID: "r-1", Name: "app", Namespace: org.Name, Visibility: "private"}
+	lib := client.ResponseRepo{ID: "r-2", Name: "lib", Namespace: org.Name, Visibility: "public", ScanOnPush: true}
+	chart := client.ChartVersion{Name: "mychart", Version: "0.1.0", AppVersion: "1.0", Digest: "sha256:c0ffee", Created: "2024-01-01T00:00:00Z"}
+	policy := client.ResponsePruningPolicy{
+		ID:      "p-1",
+		Enabled: true,
+		Rules:   []client.PruningPolicyRuleAPI{{Field: "tag", Operator: "matches", Values: []string{"^dev-"}}},
+	}
+
+	reply := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Errorf("encoding the fake MSR response: %s", err)
+		}
+	}
+	notFound := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusNotFound)
+		reply(w, client.ResponseError{Errors: []client.Errors{{Code: "NOT_FOUND", Message: "not found"}}})
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /enzi/v0/accounts", func(w http.ResponseWriter, r *http.Request) {
+		accounts := []client.ResponseAccount{}
+		for _, acc := range []client.ResponseAccount{user, admin, org} {
+			if client.AccountFilter(r.URL.Query().Get("filter")).Matches(acc) {
+				accounts = append(accounts, acc)
+			}
+		}
+		reply(w, map[string][]client.ResponseAccount{"accounts": accounts})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{org}/teams", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string][]client.Team{"teams": {team, ops}})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{account}", func(w http.ResponseWriter, r *http.Request) {
+		for _, acc := range []client.ResponseAccount{user, org} {
+			if r.PathValue("account") == acc.Name || r.PathValue("account") == acc.ID {
+				reply(w, acc)
+				return
+			}
+		}
+		notFound(w)
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{org}/teams/{team}", func(w http.ResponseWriter, r *http.Request) {
+		for _, tm := range []client.Team{team, ops} {
+			if r.PathValue("team") == tm.Name || r.PathValue("team") == tm.ID {
+				reply(w, tm)
+				return
+			}
+		}
+		notFound(w)
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{org}/teams/{team}/memberSyncConfig", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, client.MemberSyncOpts{})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{org}/teams/{team}/members", func(w http.ResponseWriter, r *http.Request) {
+		// alice is the only member of devs, ops has no members
+		members := []map[string]any{}
+		if r.PathValue("team") == team.ID {
+			members = append(members, map[string]any{"isAdmin": false, "member": user})
+		}
+		reply(w, map[string]any{"members": members})
+	})
+	mux.HandleFunc("GET /api/v0/repositories", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string][]client.ResponseRepo{"repositories": {repo, lib}})
+	})
+	mux.HandleFunc("GET /api/v0/repositories/{namespace}", func(w http.ResponseWriter, r *http.Request) {
+		repos := []client.ResponseRepo{}
+		if r.PathValue("namespace") == org.Name {
+			repos = append(repos, repo, lib)
+		}
+		reply(w, map[string][]client.ResponseRepo{"repositories": repos})
+	})
+	mux.HandleFunc("GET /api/v0/repositories/{namespace}/{repo}", func(w http.ResponseWriter, r *http.Request) {
+		for _, rp := range []client.ResponseRepo{repo, lib} {
+			if r.PathValue("namespace") == rp.Namespace && r.PathValue("repo") == rp.Name {
+				reply(w, rp)
+				return
+			}
+		}
+		notFound(w)
+	})
+	mux.HandleFunc("GET /api/v0/repositories/{namespace}/{repo}/pruningPolicies", func(w http.ResponseWriter, r *http.Request) {
+		policies := []client.ResponsePruningPolicy{}
+		if r.PathValue("repo") == repo.Name {
+			policies = append(policies, policy)
+		}
+		reply(w, policies)
+	})
+	mux.HandleFunc("GET /api/v0/repositories/{namespace}/{repo}/pruningPolicies/{policy}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("policy") != policy.ID {
+			notFound(w)
+			return
+		}
+		reply(w, policy)
+	})
+	mux.HandleFunc("GET /charts/api/{namespace}/{repo}/charts/{chart}/{version}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("namespace") != org.Name || r.PathValue("repo") != repo.Name || r.PathValue("chart") != chart.Name || r.PathValue("version") != chart.Version {
+			notFound(w)
+			return
+		}
+		reply(w, chart)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testListResults runs a list resource against the fake MSR, out of testing mode, with the given list block config.
+// The attributes left out of the config are null, and a zero limit lists everything.
+func testListResults(t *testing.T, server *httptest.Server, lr list.ListResource, r resource.Resource, config map[string]string, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	c, err := client.NewClient("admin", "admin", server.URL, false, server.Client())
+	if err != nil {
+		t.Fatalf("creating the client: %s", err)
+	}
+	configureResp := resource.ConfigureResponse{}
+	lr.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configuring the list resource: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	resourceSchemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range configType.AttributeTypes {
+		if v, ok := config[name]; ok {
+			values[name] = tftypes.NewValue(typ, v)
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(configType, values),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+	stream := list.ListResultsStream{}
+	lr.List(ctx, req, &stream)
+
+	results := slices.Collect(stream.Results)
+	for _, result := range results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("listing: %v", result.Diagnostics)
+		}
+	}
+	return results
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource             = &GCRunResource{}
+	_ resource.ResourceWithIdentity = &GCRunResource{}
+)
+
+type GCRunResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Duration          types.String   `tfsdk:"duration"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Status            types.String   `tfsdk:"status"`
+	WorkerID          types.String   `tfsdk:"worker_id"`
+	ScheduledAt       types.String   `tfsdk:"scheduled_at"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GCRunResourceIdentityModel identifies a run by the ID of its job.
+type GCRunResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type GCRunResource struct {
+	client client.Client
+}
+
+func NewGCRunResource() resource.Resource {
+	return &GCRunResource{}
+}
+
+func (r *GCRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gc_run"
+}
+
+func (r *GCRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Triggers an online garbage collection. A new garbage collection runs whenever the resource is replaced, e.g. when `triggers` change",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The garbage collection job identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "How long garbage collection runs before being stopped, e.g. `30m`. Garbage collection runs until done when unset",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause a new garbage collection to run when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the garbage collection job to finish before completing the apply",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the garbage collection job",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"worker_id": schema.StringAttribute{
+				MarkdownDescription: "The worker running the garbage collection job",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheduled_at": schema.StringAttribute{
+				MarkdownDescription: "The time the garbage collection job was scheduled at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "The time the garbage collection job was last updated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GCRunResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the garbage collection job",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *GCRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GCRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GCRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr gc run resource handler is in testing mode, no creation will be run.")
+		data.fromJob(client.ResponseJob{
+			ID:          TestingVersion,
+			Status:      client.JobDone,
+			WorkerID:    TestingVersion,
+			ScheduledAt: TestingVersion,
+			LastUpdated: TestingVersion,
+		})
+	} else {
+		job := client.CreateJob{
+			Action:   client.OnlineGCAction,
+			Deadline: data.Duration.ValueString(),
+		}
+		rJob, err := r.client.CreateJob(ctx, job)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create gc run error",
+				err.Error(),
+			)
+			return
+		}
+		tflog.Trace(ctx, fmt.Sprintf("created gc job `%s`", rJob.ID))
+
+		if data.WaitForCompletion.ValueBool() {
+			finishedJob, err := r.client.WaitForJob(ctx, rJob.ID, client.DefaultJobPollInterval)
+			if err != nil {
+				// Keep the job in state so that it gets tainted and run again on the next apply
+				if finishedJob.ID != "" {
+					rJob = finishedJob
+				}
+				data.fromJob(rJob)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+				resp.Diagnostics.AddError(
+					"Garbage collection did not complete",
+					err.Error(),
+				)
+				return
+			}
+			rJob = finishedJob
+		}
+
+		data.fromJob(rJob)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *GCRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read gc run resource")
+	var data *GCRunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr gc run resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rJob, err := r.client.ReadJob(ctx, data.Id.ValueString())
+		switch {
+		case errors.Is(err, client.ErrNotFound):
+			// MSR pruned the finished job, the run is kept as last read
+			tflog.Debug(ctx, fmt.Sprintf("gc run job `%s` was pruned, keeping its state", data.Id.ValueString()))
+		case err != nil:
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		default:
+			data.fromJob(rJob)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *GCRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute triggering a garbage collection requires replacement,
+	// the remaining ones only affect how the next run is handled.
+	// The job attributes are kept from the state by their plan modifiers.
+	var data *GCRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *GCRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Finished jobs can't be removed from MSR, the run is only dropped from the state.
+	tflog.Trace(ctx, "No action taken. gc run resource is removed from the state only.")
+}
+
+func (r *GCRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), true)...)
+}
+
+// identity returns the identity of the run.
+func (m *GCRunResourceModel) identity() GCRunResourceIdentityModel {
+	return GCRunResourceIdentityModel{
+		Id: m.Id,
+	}
+}
+
+// fromJob refreshes the model from the garbage collection job.
+func (m *GCRunResourceModel) fromJob(job client.ResponseJob) {
+	m.Id = types.StringValue(job.ID)
+	m.Status = types.StringValue(job.Status)
+	m.WorkerID = types.StringValue(job.WorkerID)
+	m.ScheduledAt = types.StringValue(job.ScheduledAt)
+	m.LastUpdated = types.StringValue(job.LastUpdated)
+}
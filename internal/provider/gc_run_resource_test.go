@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestGCRunResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testGCRunResource("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_gc_run.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_gc_run.test", "status", client.JobDone),
+					resource.TestCheckResourceAttr("msr_gc_run.test", "wait_for_completion", "true"),
+					resource.TestCheckResourceAttrSet("msr_gc_run.test", "scheduled_at"),
+				),
+			},
+			// Replace testing
+			{
+				Config: providerConfig + testGCRunResource("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_gc_run.test", "triggers.release", "2"),
+					resource.TestCheckResourceAttr("msr_gc_run.test", "status", client.JobDone),
+				),
+			},
+			// Update testing, the job attributes are kept from the state
+			{
+				Config: providerConfig + testGCRunResourceNoWait("2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_gc_run.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("msr_gc_run.test", tfjsonpath.New("status"), knownvalue.StringExact(client.JobDone)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_gc_run.test", "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr("msr_gc_run.test", "status", client.JobDone),
+					resource.TestCheckResourceAttr("msr_gc_run.test", "worker_id", TestingVersion),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testGCRunResource(release string) string {
+	return `
+	resource "msr_gc_run" "test" {
+		duration = "10m"
+		triggers = {
+			release = "` + release + `"
+		}
+	}`
+}
+
+func testGCRunResourceNoWait(release string) string {
+	return `
+	resource "msr_gc_run" "test" {
+		duration = "10m"
+		triggers = {
+			release = "` + release + `"
+		}
+		wait_for_completion = false
+	}`
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource             = &GCScheduleResource{}
+	_ resource.ResourceWithIdentity = &GCScheduleResource{}
+)
+
+type GCScheduleResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Schedule    types.String   `tfsdk:"schedule"`
+	Duration    types.String   `tfsdk:"duration"`
+	StopTimeout types.String   `tfsdk:"stop_timeout"`
+	NextRun     types.String   `tfsdk:"next_run"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GCScheduleResourceIdentityModel identifies the garbage collection schedule by its cron action.
+type GCScheduleResourceIdentityModel struct {
+	Action types.String `tfsdk:"action"`
+}
+
+type GCScheduleResource struct {
+	client client.Client
+}
+
+func NewGCScheduleResource() resource.Resource {
+	return &GCScheduleResource{}
+}
+
+func (r *GCScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gc_schedule"
+}
+
+func (r *GCScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Garbage collection schedule resource. There is a single schedule per MSR instance.",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, the action of the cron",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "The cron expression of the garbage collection schedule, e.g. `0 0 1 * * 6`",
+				Required:            true,
+				Validators:          []validator.String{cronExpressionValidator{}},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "How long garbage collection runs before being stopped, e.g. `30m`. Garbage collection runs until done when unset",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"stop_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long MSR waits for garbage collection to stop once `duration` has elapsed, e.g. `5m`",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"next_run": schema.StringAttribute{
+				MarkdownDescription: "The time of the next scheduled garbage collection",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GCScheduleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"action": identityschema.StringAttribute{
+				Description:       "The action of the cron, always `onlinegc`",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *GCScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GCScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GCScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr gc schedule resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+		data.NextRun = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rCron, err := r.client.CreateCron(ctx, data.toCron())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create gc schedule error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("created gc schedule resource `%s`", rCron.Schedule))
+		data.fromCron(rCron)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *GCScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read gc schedule resource")
+	var data *GCScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr gc schedule resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rCron, err := r.client.ReadCron(ctx, client.OnlineGCAction)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromCron(rCron)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *GCScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update gc schedule resource")
+
+	var data *GCScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr gc schedule resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+		data.NextRun = types.StringValue(TestingVersion)
+	} else {
+		// Creating the cron of an action replaces the existing one
+		rCron, err := r.client.CreateCron(ctx, data.toCron())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromCron(rCron)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'gc schedule' resource", map[string]any{"success": true})
+}
+
+func (r *GCScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GCScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr gc schedule resource handler is in testing mode, no deletion will be run.")
+	} else if err := r.client.DeleteCron(ctx, client.OnlineGCAction); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted gc schedule resource", map[string]any{"success": true})
+}
+
+func (r *GCScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The cron is read by its action whatever the ID
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("action"), req, resp)
+}
+
+// identity returns the identity of the schedule.
+// The ID of the cron changes when it is updated, the identity is the cron action.
+func (m *GCScheduleResourceModel) identity() GCScheduleResourceIdentityModel {
+	return GCScheduleResourceIdentityModel{
+		Action: types.StringValue(client.OnlineGCAction),
+	}
+}
+
+// toCron converts the model to the online garbage collection cron.
+func (m *GCScheduleResourceModel) toCron() client.Cron {
+	return client.Cron{
+		Action:      client.OnlineGCAction,
+		Schedule:    m.Schedule.ValueString(),
+		Deadline:    m.Duration.ValueString(),
+		StopTimeout: m.StopTimeout.ValueString(),
+	}
+}
+
+// fromCron refreshes the model from the online garbage collection cron.
+// The ID of the cron changes when it is updated, the model is identified by the cron action.
+func (m *GCScheduleResourceModel) fromCron(cron client.Cron) {
+	m.Id = types.StringValue(client.OnlineGCAction)
+	m.Schedule = types.StringValue(cron.Schedule)
+	m.Duration = durationValue(m.Duration, cron.Deadline)
+	m.StopTimeout = durationValue(m.StopTimeout, cron.StopTimeout)
+	m.NextRun = types.StringValue(cron.NextRun)
+}
+
+// durationValue returns the duration returned by MSR, keeping the prior
+// value when both describe the same duration in a different notation (`1h` and `1h0m0s`).
+func durationValue(prior types.String, remote string) types.String {
+	if remote == "" {
+		return types.StringNull()
+	}
+	p, errP := time.ParseDuration(prior.ValueString())
+	r, errR := time.ParseDuration(remote)
+	if errP == nil && errR == nil && p == r {
+		return prior
+	}
+	return types.StringValue(remote)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGCScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Resource identities need Terraform 1.12
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			// Plan time cron validation
+			{
+				Config:      providerConfig + testGCScheduleResource("0 0 25 * * 6"),
+				ExpectError: regexp.MustCompile("Invalid cron expression"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testGCScheduleResource("0 0 1 * * 6"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_gc_schedule.test", "schedule", "0 0 1 * * 6"),
+					resource.TestCheckResourceAttr("msr_gc_schedule.test", "duration", "30m"),
+					resource.TestCheckResourceAttr("msr_gc_schedule.test", "id", TestingVersion),
+					resource.TestCheckResourceAttrSet("msr_gc_schedule.test", "next_run"),
+				),
+				// The ID of the cron changes on update, the identity doesn't
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("msr_gc_schedule.test", map[string]knownvalue.Check{
+						"action": knownvalue.StringExact("onlinegc"),
+					}),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testGCScheduleResource("@weekly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_gc_schedule.test", "schedule", "@weekly"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testGCScheduleResource(schedule string) string {
+	return `
+	resource "msr_gc_schedule" "test" {
+		schedule = "` + schedule + `"
+		duration = "30m"
+	}`
+}
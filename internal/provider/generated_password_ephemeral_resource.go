@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &GeneratedPasswordEphemeralResource{}
+
+type GeneratedPasswordEphemeralResourceModel struct {
+	Length  types.Int64  `tfsdk:"length"`
+	Lower   types.Bool   `tfsdk:"lower"`
+	Upper   types.Bool   `tfsdk:"upper"`
+	Numeric types.Bool   `tfsdk:"numeric"`
+	Special types.Bool   `tfsdk:"special"`
+	Result  types.String `tfsdk:"result"`
+}
+
+// GeneratedPasswordEphemeralResource generates passwords that are never stored in the state.
+// It doesn't talk to MSR, so it also runs in testing mode.
+type GeneratedPasswordEphemeralResource struct{}
+
+func NewGeneratedPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &GeneratedPasswordEphemeralResource{}
+}
+
+func (r *GeneratedPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_generated_password"
+}
+
+func (r *GeneratedPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a random password from a cryptographically secure source. " +
+			"The password is never stored in the state, pass it to a write-only attribute such as `msr_user.password_wo`",
+
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "The length of the password, defaults to 16",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.Between(8, 16)},
+			},
+			"lower": schema.BoolAttribute{
+				MarkdownDescription: "Include lower case letters, defaults to true",
+				Optional:            true,
+				Computed:            true,
+			},
+			"upper": schema.BoolAttribute{
+				MarkdownDescription: "Include upper case letters, defaults to true",
+				Optional:            true,
+				Computed:            true,
+			},
+			"numeric": schema.BoolAttribute{
+				MarkdownDescription: "Include numbers, defaults to true",
+				Optional:            true,
+				Computed:            true,
+			},
+			"special": schema.BoolAttribute{
+				MarkdownDescription: "Include special characters, defaults to true",
+				Optional:            true,
+				Computed:            true,
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "The generated password",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *GeneratedPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data *GeneratedPasswordEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy := client.DefaultPasswordPolicy
+	if !data.Length.IsNull() {
+		policy.Length = int(data.Length.ValueInt64())
+	}
+	for _, c := range []struct {
+		value   types.Bool
+		enabled *bool
+	}{
+		{data.Lower, &policy.Lower},
+		{data.Upper, &policy.Upper},
+		{data.Numeric, &policy.Numeric},
+		{data.Special, &policy.Special},
+	} {
+		if !c.value.IsNull() {
+			*c.enabled = c.value.ValueBool()
+		}
+	}
+
+	pass, err := client.GeneratePassword(policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid password policy", err.Error())
+		return
+	}
+
+	data.Length = types.Int64Value(int64(policy.Length))
+	data.Lower = types.BoolValue(policy.Lower)
+	data.Upper = types.BoolValue(policy.Upper)
+	data.Numeric = types.BoolValue(policy.Numeric)
+	data.Special = types.BoolValue(policy.Special)
+	data.Result = types.StringValue(pass)
+
+	tflog.Trace(ctx, "generated password")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGeneratedPasswordEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// A password needs at least one character class
+			{
+				Config: providerConfig + `
+				ephemeral "msr_generated_password" "test" {
+					lower   = false
+					upper   = false
+					numeric = false
+					special = false
+				}`,
+				ExpectError: regexp.MustCompile("Invalid password policy"),
+			},
+			// The generated password is only stored in MSR
+			{
+				Config: providerConfig + testGeneratedPasswordUser(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("msr_user.test", "password"),
+					resource.TestCheckNoResourceAttr("msr_user.test", "password_wo"),
+					resource.TestCheckResourceAttr("msr_user.test", "password_wo_version", "1"),
+				),
+			},
+			// Rotate the password
+			{
+				Config: providerConfig + testGeneratedPasswordUser(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("msr_user.test", "password_wo"),
+					resource.TestCheckResourceAttr("msr_user.test", "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testGeneratedPasswordUser(version int) string {
+	return fmt.Sprintf(`
+	ephemeral "msr_generated_password" "test" {
+		length  = 12
+		special = false
+	}
+
+	resource "msr_user" "test" {
+		name                = "test"
+		password_wo         = ephemeral.msr_generated_password.test.result
+		password_wo_version = %d
+	}`, version)
+}
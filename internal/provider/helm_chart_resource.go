@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &HelmChartResource{}
+	_ resource.ResourceWithIdentity    = &HelmChartResource{}
+	_ resource.ResourceWithImportState = &HelmChartResource{}
+)
+
+type HelmChartResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	OrgName    types.String   `tfsdk:"org_name"`
+	RepoName   types.String   `tfsdk:"repo_name"`
+	Path       types.String   `tfsdk:"path"`
+	SourceHash types.String   `tfsdk:"source_hash"`
+	Force      types.Bool     `tfsdk:"force"`
+	Name       types.String   `tfsdk:"name"`
+	Version    types.String   `tfsdk:"version"`
+	AppVersion types.String   `tfsdk:"app_version"`
+	Digest     types.String   `tfsdk:"digest"`
+	Created    types.String   `tfsdk:"created"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// HelmChartResourceIdentityModel identifies a chart version by its repo, name and version.
+type HelmChartResourceIdentityModel struct {
+	OrgName  types.String `tfsdk:"org_name"`
+	RepoName types.String `tfsdk:"repo_name"`
+	Name     types.String `tfsdk:"name"`
+	Version  types.String `tfsdk:"version"`
+}
+
+type HelmChartResource struct {
+	client client.Client
+}
+
+func NewHelmChartResource() resource.Resource {
+	return &HelmChartResource{}
+}
+
+func (r *HelmChartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_helm_chart"
+}
+
+func (r *HelmChartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Helm chart resource. Publishes a chart package to a repo, the chart version is deleted from the repo on destroy",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "The organization that contains the repo",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				MarkdownDescription: "The repository to publish the chart to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The local path of the chart package, as built by `helm package`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					// An imported chart has no path, it is not published again when the path is set
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Republishes the chart unless it was imported", "Republishes the chart unless it was imported"),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "A hash of the chart package, e.g. `filesha256(path)`, republishing the chart when it changes",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Overwrite the chart version if it already exists in the repo",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the chart",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the chart",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the application packaged by the chart",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"digest": schema.StringAttribute{
+				MarkdownDescription: "The digest of the chart package stored in MSR",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The time the chart version was published at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *HelmChartResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_name": identityschema.StringAttribute{
+				Description:       "The organization name for the repo",
+				RequiredForImport: true,
+			},
+			"repo_name": identityschema.StringAttribute{
+				Description:       "The name of the repo",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the chart",
+				RequiredForImport: true,
+			},
+			"version": identityschema.StringAttribute{
+				Description:       "The version of the chart",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *HelmChartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelmChartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *HelmChartResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr helm chart resource handler is in testing mode, no creation will be run.")
+		// The package is still read so that its metadata can be checked
+		pkg, err := os.ReadFile(data.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		meta, err := client.ReadChartMetadata(pkg)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		meta.Digest = TestingVersion
+		meta.Created = TestingVersion
+		data.fromChartVersion(meta)
+		data.Id = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rChart, err := r.client.UploadChartPackage(ctx, data.OrgName.ValueString(), data.RepoName.ValueString(), data.Path.ValueString(), data.Force.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create helm chart error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("published helm chart `%s-%s`", rChart.Name, rChart.Version))
+		data.fromChartVersion(rChart)
+		data.Id = basetypes.NewStringValue(data.chartID())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *HelmChartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read helm chart resource")
+	var data *HelmChartResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr helm chart resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rChart, err := r.client.ReadChartVersion(ctx, data.OrgName.ValueString(), data.RepoName.ValueString(), data.Name.ValueString(), data.Version.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromChartVersion(rChart)
+		data.Id = types.StringValue(data.chartID())
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *HelmChartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update helm chart resource")
+
+	var data *HelmChartResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only force can be updated in place, and it only matters when publishing
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr helm chart resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'helm chart' resource", map[string]any{"success": true})
+}
+
+func (r *HelmChartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *HelmChartResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr helm chart resource handler is in testing mode, no deletion will be run.")
+	} else if err := r.client.DeleteChartVersion(ctx, data.OrgName.ValueString(), data.RepoName.ValueString(), data.Name.ValueString(), data.Version.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted helm chart resource", map[string]any{"success": true})
+}
+
+func (r *HelmChartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Force only matters when publishing, an imported chart starts from its default
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force"), false)...)
+
+	if req.ID == "" {
+		var identity HelmChartResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), identity.OrgName)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), identity.RepoName)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identity.Name)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), identity.Version)...)
+		return
+	}
+
+	orgName, repoName, rest, diags := importRepo(ctx, r.client, req.ID, 2, "org_name/repo_name/name/version or repo_id/name/version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), orgName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), repoName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), rest[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), rest[1])...)
+}
+
+// chartID returns the ID of the chart version, made of its repo, name and version.
+func (m *HelmChartResourceModel) chartID() string {
+	return strings.Join([]string{m.OrgName.ValueString(), m.RepoName.ValueString(), m.Name.ValueString(), m.Version.ValueString()}, "/")
+}
+
+// fromChartVersion refreshes the computed attributes from the MSR chart version.
+func (m *HelmChartResourceModel) fromChartVersion(chart client.ChartVersion) {
+	m.Name = types.StringValue(chart.Name)
+	m.Version = types.StringValue(chart.Version)
+	m.AppVersion = types.StringValue(chart.AppVersion)
+	m.Digest = types.StringValue(chart.Digest)
+	m.Created = types.StringValue(chart.Created)
+}
+
+// identity returns the identity of the chart version.
+func (m *HelmChartResourceModel) identity() HelmChartResourceIdentityModel {
+	return HelmChartResourceIdentityModel{
+		OrgName:  m.OrgName,
+		RepoName: m.RepoName,
+		Name:     m.Name,
+		Version:  m.Version,
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testHelmChartPackage writes a minimal chart package and returns its path.
+func testHelmChartPackage(t *testing.T, version string) string {
+	pkgPath := filepath.Join(t.TempDir(), "mychart-"+version+".tgz")
+	f, err := os.Create(pkgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	chartYaml := "apiVersion: v2\nname: mychart\nversion: " + version + "\nappVersion: \"1.0\"\n"
+	if err := tw.WriteHeader(&tar.Header{Name: "mychart/Chart.yaml", Mode: 0o600, Size: int64(len(chartYaml))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(chartYaml)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return pkgPath
+}
+
+func TestHelmChartResource(t *testing.T) {
+	pkgV1 := testHelmChartPackage(t, "0.1.0")
+	pkgV2 := testHelmChartPackage(t, "0.2.0")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testHelmChartResource(pkgV1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_helm_chart.test", "name", "mychart"),
+					resource.TestCheckResourceAttr("msr_helm_chart.test", "version", "0.1.0"),
+					resource.TestCheckResourceAttr("msr_helm_chart.test", "app_version", "1.0"),
+					resource.TestCheckResourceAttr("msr_helm_chart.test", "force", "false"),
+					resource.TestCheckResourceAttr("msr_helm_chart.test", "id", TestingVersion),
+				),
+			},
+			// Replace testing
+			{
+				Config: providerConfig + testHelmChartResource(pkgV2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_helm_chart.test", "version", "0.2.0"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testHelmChartResource(path string) string {
+	return `
+	resource "msr_helm_chart" "test" {
+		org_name  = "test"
+		repo_name = "test"
+		path      = "` + path + `"
+	}`
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &helmChartsDataSource{}
+)
+
+func NewHelmChartsDataSource() datasource.DataSource {
+	return &helmChartsDataSource{}
+}
+
+type helmChartsDataSource struct {
+	client client.Client
+}
+
+// helmChartsDataSourceModel maps the data source schema data.
+type helmChartsDataSourceModel struct {
+	ID       types.String         `tfsdk:"id"`
+	OrgName  types.String         `tfsdk:"org_name"`
+	RepoName types.String         `tfsdk:"repo_name"`
+	Name     types.String         `tfsdk:"name"`
+	Charts   []helmChartDataModel `tfsdk:"charts"`
+}
+
+// helmChartDataModel maps a single chart and its versions.
+type helmChartDataModel struct {
+	Name     types.String                `tfsdk:"name"`
+	Versions []helmChartVersionDataModel `tfsdk:"versions"`
+}
+
+// helmChartVersionDataModel maps a single chart version.
+type helmChartVersionDataModel struct {
+	Version     types.String `tfsdk:"version"`
+	AppVersion  types.String `tfsdk:"app_version"`
+	Description types.String `tfsdk:"description"`
+	Digest      types.String `tfsdk:"digest"`
+	Created     types.String `tfsdk:"created"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *helmChartsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *helmChartsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_helm_charts"
+}
+
+func (d *helmChartsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Helm charts data source. Lists the charts of a repo and their versions, sorted by name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "The organization that contains the repo",
+				Required:            true,
+			},
+			"repo_name": schema.StringAttribute{
+				MarkdownDescription: "The repository to retrieve the charts of",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve the chart with this name",
+				Optional:            true,
+			},
+			"charts": schema.ListNestedAttribute{
+				MarkdownDescription: "The charts of the repo",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the chart",
+							Computed:            true,
+						},
+						"versions": schema.ListNestedAttribute{
+							MarkdownDescription: "The versions of the chart, as ordered by MSR",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"version": schema.StringAttribute{
+										MarkdownDescription: "The version of the chart",
+										Computed:            true,
+									},
+									"app_version": schema.StringAttribute{
+										MarkdownDescription: "The version of the application packaged by the chart",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "The description of the chart",
+										Computed:            true,
+									},
+									"digest": schema.StringAttribute{
+										MarkdownDescription: "The digest of the chart package",
+										Computed:            true,
+									},
+									"created": schema.StringAttribute{
+										MarkdownDescription: "The time the chart version was published at",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *helmChartsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read helm charts data source")
+	var data helmChartsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.OrgName.ValueString(),
+		data.RepoName.ValueString(),
+		data.Name.ValueString(),
+	))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr helm charts datasource handler is in testing mode, no injestion will be run.")
+		data.Charts = []helmChartDataModel{}
+	} else {
+		rCharts, err := d.client.ReadCharts(ctx, data.OrgName.ValueString(), data.RepoName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Helm Charts",
+				err.Error(),
+			)
+			return
+		}
+
+		// MSR returns the charts as a map, sort them for a stable output
+		names := make([]string, 0, len(rCharts))
+		for name := range rCharts {
+			if !data.Name.IsNull() && name != data.Name.ValueString() {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		charts := []helmChartDataModel{}
+		for _, name := range names {
+			versions := []helmChartVersionDataModel{}
+			for _, v := range rCharts[name] {
+				versions = append(versions, helmChartVersionDataModel{
+					Version:     basetypes.NewStringValue(v.Version),
+					AppVersion:  basetypes.NewStringValue(v.AppVersion),
+					Description: basetypes.NewStringValue(v.Description),
+					Digest:      basetypes.NewStringValue(v.Digest),
+					Created:     basetypes.NewStringValue(v.Created),
+				})
+			}
+			charts = append(charts, helmChartDataModel{
+				Name:     basetypes.NewStringValue(name),
+				Versions: versions,
+			})
+		}
+		data.Charts = charts
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d charts in helm charts data source", len(charts)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading helm charts data source", map[string]any{"success": true})
+}
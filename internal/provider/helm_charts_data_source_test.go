@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestHelmChartsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_helm_charts" "test" {
+					org_name  = "test"
+					repo_name = "test"
+					name      = "mychart"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_helm_charts.test", "charts.#", "0"),
+					resource.TestCheckResourceAttr("data.msr_helm_charts.test", "name", "mychart"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.msr_helm_charts.test", "id"),
+				),
+			},
+		},
+	})
+}
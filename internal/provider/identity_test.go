@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testReadIdentity refreshes the prior state against the fake MSR, out of testing mode, without a prior identity
+// as for the states written before the resources had one, and decodes the identity set by the read.
+func testReadIdentity(t *testing.T, server *httptest.Server, r resource.Resource, prior any, identity any) {
+	t.Helper()
+	ctx := context.Background()
+
+	c, err := client.NewClient("admin", "admin", server.URL, false, server.Client())
+	if err != nil {
+		t.Fatalf("creating the client: %s", err)
+	}
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configuring the resource: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, prior); diags.HasError() {
+		t.Fatalf("setting the prior state: %v", diags)
+	}
+	noIdentity := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+
+	req := resource.ReadRequest{State: state, Identity: noIdentity}
+	resp := resource.ReadResponse{State: state, Identity: noIdentity}
+	r.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+	if diags := resp.Identity.Get(ctx, identity); diags.HasError() {
+		t.Fatalf("reading the identity: %v", diags)
+	}
+}
+
+func TestReadSetsMissingIdentity(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	t.Run("org", func(t *testing.T) {
+		var identity OrgResourceIdentityModel
+		testReadIdentity(t, server, NewOrgResource(), OrgResourceModel{
+			Id:                 types.StringValue("o-1"),
+			Timeouts:           nullTimeouts(),
+			Name:               types.StringValue("acme"),
+			DeletionProtection: types.BoolValue(false),
+			OnDestroy:          types.StringValue(onDestroyDelete),
+		}, &identity)
+		// The identity holds the name the org is imported with, not its ID
+		if identity.Name.ValueString() != "acme" {
+			t.Errorf("expected the acme identity, got %s", identity.Name)
+		}
+	})
+
+	t.Run("team", func(t *testing.T) {
+		var identity TeamResourceIdentityModel
+		testReadIdentity(t, server, NewTeamResource(), TeamResourceModel{
+			Id:                 types.StringValue("t-1"),
+			Timeouts:           nullTimeouts(),
+			Name:               types.StringValue("devs"),
+			OrgID:              types.StringValue("acme"),
+			Description:        types.StringValue("developers"),
+			UserIDs:            types.SetNull(types.StringType),
+			DeletionProtection: types.BoolValue(false),
+			OnDestroy:          types.StringValue(onDestroyDelete),
+		}, &identity)
+		if identity.OrgID.ValueString() != "acme" || identity.TeamID.ValueString() != "t-1" {
+			t.Errorf("expected the acme/t-1 identity, got %s/%s", identity.OrgID, identity.TeamID)
+		}
+	})
+
+	t.Run("repo", func(t *testing.T) {
+		var identity RepoResourceIdentityModel
+		testReadIdentity(t, server, NewRepoResource(), RepoResourceModel{
+			Id:                       types.StringValue("r-1"),
+			Timeouts:                 nullTimeouts(),
+			Name:                     types.StringValue("app"),
+			OrgName:                  types.StringValue("acme"),
+			Visibility:               types.StringValue("private"),
+			ScanOnPush:               types.BoolValue(false),
+			ImmutableTags:            types.BoolValue(false),
+			PreventDestroyIfNotEmpty: types.BoolValue(false),
+			DeletionProtection:       types.BoolValue(false),
+			OnDestroy:                types.StringValue(onDestroyDelete),
+		}, &identity)
+		if identity.OrgName.ValueString() != "acme" || identity.RepoName.ValueString() != "app" {
+			t.Errorf("expected the acme/app identity, got %s/%s", identity.OrgName, identity.RepoName)
+		}
+	})
+
+	t.Run("pruning policy", func(t *testing.T) {
+		var identity PruningPolicyResourceIdentityModel
+		testReadIdentity(t, server, NewPruningPolicyResource(), PruningPolicyResourceModel{
+			Id:       types.StringValue("p-1"),
+			Timeouts: nullTimeouts(),
+			OrgName:  types.StringValue("acme"),
+			RepoName: types.StringValue("app"),
+			Enabled:  types.BoolValue(true),
+		}, &identity)
+		if identity.PolicyID.ValueString() != "p-1" {
+			t.Errorf("expected the p-1 identity, got %s", identity.PolicyID)
+		}
+	})
+}
+
+// testImportByIdentity runs the import of the resource from the identity, out of testing mode, and decodes the imported state.
+func testImportByIdentity(t *testing.T, server *httptest.Server, r resource.Resource, identity any, imported any) {
+	t.Helper()
+	ctx := context.Background()
+
+	c, err := client.NewClient("admin", "admin", server.URL, false, server.Client())
+	if err != nil {
+		t.Fatalf("creating the client: %s", err)
+	}
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &configureResp)
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	req := resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchemaResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	if diags := req.Identity.Set(ctx, identity); diags.HasError() {
+		t.Fatalf("setting the identity: %v", diags)
+	}
+	resp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		Identity: req.Identity,
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("importing: %v", resp.Diagnostics)
+	}
+	if diags := resp.State.Get(ctx, imported); diags.HasError() {
+		t.Fatalf("reading the imported state: %v", diags)
+	}
+}
+
+func TestImportByIdentity(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	t.Run("team", func(t *testing.T) {
+		var imported TeamResourceModel
+		testImportByIdentity(t, server, NewTeamResource(), TeamResourceIdentityModel{
+			OrgID:  types.StringValue("acme"),
+			TeamID: types.StringValue("t-1"),
+		}, &imported)
+		// The team is then read by its ID
+		if imported.OrgID.ValueString() != "acme" || imported.Id.ValueString() != "t-1" || !imported.Name.IsNull() {
+			t.Errorf("unexpected imported team %+v", imported)
+		}
+	})
+
+	t.Run("pruning policy", func(t *testing.T) {
+		var imported PruningPolicyResourceModel
+		testImportByIdentity(t, server, NewPruningPolicyResource(), PruningPolicyResourceIdentityModel{
+			OrgName:  types.StringValue("acme"),
+			RepoName: types.StringValue("app"),
+			PolicyID: types.StringValue("p-1"),
+		}, &imported)
+		if imported.OrgName.ValueString() != "acme" || imported.RepoName.ValueString() != "app" || imported.Id.ValueString() != "p-1" {
+			t.Errorf("unexpected imported policy %+v", imported)
+		}
+	})
+
+	t.Run("repo user access", func(t *testing.T) {
+		var imported RepoUserAccessResourceModel
+		testImportByIdentity(t, server, NewRepoUserAccessResource(), RepoUserAccessResourceIdentityModel{
+			Namespace: types.StringValue("acme"),
+			RepoName:  types.StringValue("app"),
+			Username:  types.StringValue("alice"),
+		}, &imported)
+		if imported.Namespace.ValueString() != "acme" || imported.RepoName.ValueString() != "app" || imported.Username.ValueString() != "alice" {
+			t.Errorf("unexpected imported access %+v", imported)
+		}
+	})
+
+	t.Run("helm chart", func(t *testing.T) {
+		var imported HelmChartResourceModel
+		testImportByIdentity(t, server, NewHelmChartResource(), HelmChartResourceIdentityModel{
+			OrgName:  types.StringValue("acme"),
+			RepoName: types.StringValue("app"),
+			Name:     types.StringValue("mychart"),
+			Version:  types.StringValue("0.1.0"),
+		}, &imported)
+		if imported.OrgName.ValueString() != "acme" || imported.RepoName.ValueString() != "app" || imported.Name.ValueString() != "mychart" || imported.Version.ValueString() != "0.1.0" {
+			t.Errorf("unexpected imported chart %+v", imported)
+		}
+	})
+
+	t.Run("storage backend", func(t *testing.T) {
+		var imported StorageBackendResourceModel
+		testImportByIdentity(t, server, NewStorageBackendResource(), StorageBackendResourceIdentityModel{
+			Settings: types.StringValue(storageSettingsID),
+		}, &imported)
+		if imported.Id.ValueString() != storageSettingsID {
+			t.Errorf("unexpected imported storage %+v", imported)
+		}
+	})
+}
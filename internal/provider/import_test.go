@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testImportProviderConfig configures the provider, out of testing mode, against the fake MSR.
+func testImportProviderConfig(server *httptest.Server) string {
+	return `
+	provider "msr" {
+		host = "` + server.URL + `"
+		username = "admin"
+		password = "admin"
+	}`
+}
+
+// testImportTerraformVersionChecks skip the import block tests below Terraform 1.5, which added import blocks.
+var testImportTerraformVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(tfversion.Version1_5_0),
+}
+
+// testImportProtoV6ProviderFactories serve the provider out of testing mode, so that imports read MSR.
+var testImportProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"msr": providerserver.NewProtocol6WithError(New("dev")()),
+}
+
+func TestImportThenPlanIsEmpty(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	testCases := map[string]struct {
+		resourceName string
+		config       string
+		importIDs    []string
+	}{
+		"user": {
+			resourceName: "msr_user.test",
+			config: `
+			resource "msr_user" "test" {
+				name      = "alice"
+				full_name = "Alice"
+			}`,
+			importIDs: []string{"alice", "u-1"},
+		},
+		"org": {
+			resourceName: "msr_org.test",
+			config: `
+			resource "msr_org" "test" {
+				name = "acme"
+			}`,
+			importIDs: []string{"acme", "o-1"},
+		},
+		"team": {
+			resourceName: "msr_team.test",
+			config: `
+			resource "msr_team" "test" {
+				name        = "devs"
+				org_id      = "acme"
+				description = "developers"
+				user_ids    = ["u-1"]
+			}`,
+			importIDs: []string{"acme/devs", "acme/t-1", "acme,devs"},
+		},
+		"team without members": {
+			resourceName: "msr_team.test",
+			config: `
+			resource "msr_team" "test" {
+				name   = "ops"
+				org_id = "acme"
+			}`,
+			importIDs: []string{"acme/ops"},
+		},
+		"repo": {
+			resourceName: "msr_repo.test",
+			config: `
+			resource "msr_repo" "test" {
+				name     = "app"
+				org_name = "acme"
+			}`,
+			importIDs: []string{"acme/app", "r-1", "acme,app"},
+		},
+		"pruning policy": {
+			resourceName: "msr_pruning_policy.test",
+			config: `
+			resource "msr_pruning_policy" "test" {
+				org_name  = "acme"
+				repo_name = "app"
+				rule {
+					field    = "tag"
+					operator = "matches"
+					values   = ["^dev-"]
+				}
+			}`,
+			importIDs: []string{"acme/app/p-1", "r-1/p-1", "acme,app,p-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		for _, importID := range tc.importIDs {
+			// Each import starts from an empty state, as when adopting existing objects
+			t.Run(name+" "+importID, func(t *testing.T) {
+				resource.Test(t, resource.TestCase{
+					PreCheck:                 func() { testAccPreCheck(t) },
+					ProtoV6ProviderFactories: testImportProtoV6ProviderFactories,
+					TerraformVersionChecks:   testImportTerraformVersionChecks,
+					Steps: []resource.TestStep{
+						{
+							Config:          testImportProviderConfig(server) + tc.config,
+							ResourceName:    tc.resourceName,
+							ImportState:     true,
+							ImportStateKind: resource.ImportBlockWithID,
+							ImportStateId:   importID,
+						},
+					},
+				})
+			})
+		}
+	}
+}
+
+func TestImportHelmChart(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	for _, importID := range []string{"acme/app/mychart/0.1.0", "r-1/mychart/0.1.0"} {
+		// The package path isn't known on import, it is adopted from the config without publishing the chart again
+		t.Run(importID, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testImportProtoV6ProviderFactories,
+				TerraformVersionChecks:   testImportTerraformVersionChecks,
+				Steps: []resource.TestStep{
+					{
+						Config: testImportProviderConfig(server) + `
+						resource "msr_helm_chart" "test" {
+							org_name  = "acme"
+							repo_name = "app"
+							path      = "mychart-0.1.0.tgz"
+						}`,
+						ResourceName:       "msr_helm_chart.test",
+						ImportState:        true,
+						ImportStateKind:    resource.ImportBlockWithID,
+						ImportStateId:      importID,
+						ExpectNonEmptyPlan: true,
+						ImportPlanChecks: resource.ImportPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectResourceAction("msr_helm_chart.test", plancheck.ResourceActionUpdate),
+							},
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestImportInvalidIDs(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	testCases := map[string]struct {
+		resourceName string
+		config       string
+		importID     string
+		expectError  *regexp.Regexp
+	}{
+		"pruning policy without policy ID": {
+			resourceName: "msr_pruning_policy.test",
+			config: `
+			resource "msr_pruning_policy" "test" {
+				org_name  = "acme"
+				repo_name = "app"
+			}`,
+			importID:    "acme/app/",
+			expectError: regexp.MustCompile("Unexpected Import Identifier"),
+		},
+		"unknown repo ID": {
+			resourceName: "msr_repo.test",
+			config: `
+			resource "msr_repo" "test" {
+				name     = "app"
+				org_name = "acme"
+			}`,
+			importID:    "r-3",
+			expectError: regexp.MustCompile("Repository not found"),
+		},
+		"team without org": {
+			resourceName: "msr_team.test",
+			config: `
+			resource "msr_team" "test" {
+				name   = "devs"
+				org_id = "acme"
+			}`,
+			importID:    "t-1",
+			expectError: regexp.MustCompile("Unexpected Import Identifier"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testImportProtoV6ProviderFactories,
+				TerraformVersionChecks:   testImportTerraformVersionChecks,
+				Steps: []resource.TestStep{
+					{
+						Config:          testImportProviderConfig(server) + tc.config,
+						ResourceName:    tc.resourceName,
+						ImportState:     true,
+						ImportStateKind: resource.ImportBlockWithID,
+						ImportStateId:   tc.importID,
+						ExpectError:     tc.expectError,
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &jobsDataSource{}
+)
+
+func NewJobsDataSource() datasource.DataSource {
+	return &jobsDataSource{}
+}
+
+type jobsDataSource struct {
+	client client.Client
+}
+
+// jobsDataSourceModel maps the data source schema data.
+type jobsDataSourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Action   types.String   `tfsdk:"action"`
+	Status   types.String   `tfsdk:"status"`
+	WorkerID types.String   `tfsdk:"worker_id"`
+	Limit    types.Int64    `tfsdk:"limit"`
+	Jobs     []jobDataModel `tfsdk:"jobs"`
+}
+
+// jobDataModel maps a single job.
+type jobDataModel struct {
+	ID           types.String `tfsdk:"id"`
+	Action       types.String `tfsdk:"action"`
+	Status       types.String `tfsdk:"status"`
+	WorkerID     types.String `tfsdk:"worker_id"`
+	RetryFromID  types.String `tfsdk:"retry_from_id"`
+	ScheduledAt  types.String `tfsdk:"scheduled_at"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+	RetriesLeft  types.Int64  `tfsdk:"retries_left"`
+	RetriesTotal types.Int64  `tfsdk:"retries_total"`
+	Deadline     types.String `tfsdk:"deadline"`
+	StopTimeout  types.String `tfsdk:"stop_timeout"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *jobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jobs"
+}
+
+func (d *jobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jobs data source, most recent jobs first",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve jobs running this action, e.g. `onlinegc`",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve jobs with this status",
+				Optional:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					client.JobWaiting, client.JobRunning, client.JobDone, client.JobCancelRequest,
+					client.JobCanceled, client.JobErrors, client.JobDeleted,
+				)},
+			},
+			"worker_id": schema.StringAttribute{
+				MarkdownDescription: "Only retrieve jobs run by this worker",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of jobs to retrieve",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"jobs": schema.ListNestedAttribute{
+				MarkdownDescription: "The jobs retrieved from MSR",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The action run by the job",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status the job is in or exited with",
+							Computed:            true,
+						},
+						"worker_id": schema.StringAttribute{
+							MarkdownDescription: "The worker running the job",
+							Computed:            true,
+						},
+						"retry_from_id": schema.StringAttribute{
+							MarkdownDescription: "The job this job is a retry of",
+							Computed:            true,
+						},
+						"scheduled_at": schema.StringAttribute{
+							MarkdownDescription: "The time the job was scheduled at",
+							Computed:            true,
+						},
+						"last_updated": schema.StringAttribute{
+							MarkdownDescription: "The time the job was last updated",
+							Computed:            true,
+						},
+						"retries_left": schema.Int64Attribute{
+							MarkdownDescription: "The number of retries left for the job",
+							Computed:            true,
+						},
+						"retries_total": schema.Int64Attribute{
+							MarkdownDescription: "The total number of retries for the job",
+							Computed:            true,
+						},
+						"deadline": schema.StringAttribute{
+							MarkdownDescription: "How long the job may run",
+							Computed:            true,
+						},
+						"stop_timeout": schema.StringAttribute{
+							MarkdownDescription: "How long the job is given to stop once past its deadline",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read jobs data source")
+	var data jobsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.Action.ValueString(),
+		data.Status.ValueString(),
+		data.WorkerID.ValueString(),
+		strconv.FormatInt(data.Limit.ValueInt64(), 10),
+	))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr jobs datasource handler is in testing mode, no injestion will be run.")
+		data.Jobs = []jobDataModel{}
+	} else {
+		filter := client.JobFilter{
+			Action: data.Action.ValueString(),
+			Worker: data.WorkerID.ValueString(),
+			Status: data.Status.ValueString(),
+			Limit:  int(data.Limit.ValueInt64()),
+		}
+		rJobs, err := d.client.ReadJobs(ctx, filter)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jobs",
+				err.Error(),
+			)
+			return
+		}
+
+		jobs := []jobDataModel{}
+		for _, j := range rJobs {
+			jobs = append(jobs, jobDataModel{
+				ID:           basetypes.NewStringValue(j.ID),
+				Action:       basetypes.NewStringValue(j.Action),
+				Status:       basetypes.NewStringValue(j.Status),
+				WorkerID:     basetypes.NewStringValue(j.WorkerID),
+				RetryFromID:  basetypes.NewStringValue(j.RetryFromID),
+				ScheduledAt:  basetypes.NewStringValue(j.ScheduledAt),
+				LastUpdated:  basetypes.NewStringValue(j.LastUpdated),
+				RetriesLeft:  basetypes.NewInt64Value(int64(j.RetriesLeft)),
+				RetriesTotal: basetypes.NewInt64Value(int64(j.RetriesTotal)),
+				Deadline:     basetypes.NewStringValue(j.Deadline),
+				StopTimeout:  basetypes.NewStringValue(j.StopTimeout),
+			})
+		}
+		data.Jobs = jobs
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d jobs in jobs data source", len(jobs)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading jobs data source", map[string]any{"success": true})
+}
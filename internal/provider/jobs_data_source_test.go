@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestJobsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testMSRjobsDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_jobs.test", "jobs.#", "0"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.msr_jobs.test", "id"),
+				),
+			},
+			{
+				Config: providerConfig + testMSRjobsSetValues(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_jobs.test", "action", "onlinegc"),
+					resource.TestCheckResourceAttr("data.msr_jobs.test", "status", "errors"),
+					resource.TestCheckResourceAttr("data.msr_jobs.test", "worker_id", TestingVersion),
+					resource.TestCheckResourceAttr("data.msr_jobs.test", "limit", "10"),
+					resource.TestCheckResourceAttrSet("data.msr_jobs.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testMSRjobsDefault() string {
+	return `
+	data "msr_jobs" "test" {}
+	`
+}
+
+func testMSRjobsSetValues() string {
+	return `
+	data "msr_jobs" "test" {
+		action = "onlinegc"
+		status = "errors"
+		worker_id = "test"
+		limit = 10
+	}
+	`
+}
@@ -0,0 +1,515 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource             = &LDAPSettingsResource{}
+	_ resource.ResourceWithIdentity = &LDAPSettingsResource{}
+)
+
+// ldapSettingsID is the identifier of the single LDAP configuration of MSR.
+const ldapSettingsID = "ldap"
+
+type LDAPSettingsResourceModel struct {
+	Id                  types.String          `tfsdk:"id"`
+	ServerURL           types.String          `tfsdk:"server_url"`
+	ReaderDN            types.String          `tfsdk:"reader_dn"`
+	ReaderPassword      types.String          `tfsdk:"reader_password"`
+	StartTLS            types.Bool            `tfsdk:"start_tls"`
+	TLSSkipVerify       types.Bool            `tfsdk:"tls_skip_verify"`
+	NoSimplePagination  types.Bool            `tfsdk:"no_simple_pagination"`
+	RootCerts           types.String          `tfsdk:"root_certs"`
+	SyncSchedule        types.String          `tfsdk:"sync_schedule"`
+	JitUserProvisioning types.Bool            `tfsdk:"jit_user_provisioning"`
+	AdditionalDomains   []ldapDomainModel     `tfsdk:"additional_domain"`
+	UserSearches        []ldapUserSearchModel `tfsdk:"user_search"`
+	Timeouts            timeouts.Value        `tfsdk:"timeouts"`
+}
+
+// LDAPSettingsResourceIdentityModel identifies the single LDAP settings of MSR.
+type LDAPSettingsResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type ldapDomainModel struct {
+	Domain             types.String `tfsdk:"domain"`
+	ServerURL          types.String `tfsdk:"server_url"`
+	ReaderDN           types.String `tfsdk:"reader_dn"`
+	ReaderPassword     types.String `tfsdk:"reader_password"`
+	StartTLS           types.Bool   `tfsdk:"start_tls"`
+	TLSSkipVerify      types.Bool   `tfsdk:"tls_skip_verify"`
+	NoSimplePagination types.Bool   `tfsdk:"no_simple_pagination"`
+	RootCerts          types.String `tfsdk:"root_certs"`
+}
+
+type ldapUserSearchModel struct {
+	BaseDN               types.String `tfsdk:"base_dn"`
+	ScopeSubtree         types.Bool   `tfsdk:"scope_subtree"`
+	UsernameAttr         types.String `tfsdk:"username_attr"`
+	FullNameAttr         types.String `tfsdk:"full_name_attr"`
+	Filter               types.String `tfsdk:"filter"`
+	MatchGroup           types.Bool   `tfsdk:"match_group"`
+	MatchGroupDN         types.String `tfsdk:"match_group_dn"`
+	MatchGroupMemberAttr types.String `tfsdk:"match_group_member_attr"`
+	MatchGroupIterate    types.Bool   `tfsdk:"match_group_iterate"`
+}
+
+type LDAPSettingsResource struct {
+	client client.Client
+}
+
+func NewLDAPSettingsResource() resource.Resource {
+	return &LDAPSettingsResource{}
+}
+
+func (r *LDAPSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_settings"
+}
+
+func (r *LDAPSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "LDAP settings resource. There is a single LDAP configuration per MSR instance, " +
+			"the admin sync options configured outside of Terraform are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the LDAP server, e.g. `ldaps://ldap.example.com`",
+				Required:            true,
+			},
+			"reader_dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the account used to search the directory",
+				Required:            true,
+			},
+			"reader_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the account used to search the directory",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"start_tls": schema.BoolAttribute{
+				MarkdownDescription: "Upgrade the connection with StartTLS after connecting",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tls_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the server certificate",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_simple_pagination": schema.BoolAttribute{
+				MarkdownDescription: "The server doesn't support the simple paged results control",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"root_certs": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded root certificates trusted for the server",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"sync_schedule": schema.StringAttribute{
+				MarkdownDescription: "The cron expression of the periodic user and team sync",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@hourly"),
+				Validators:          []validator.String{cronExpressionValidator{}},
+			},
+			"jit_user_provisioning": schema.BoolAttribute{
+				MarkdownDescription: "Create users on their first login instead of on sync",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+			"additional_domain": schema.ListNestedBlock{
+				MarkdownDescription: "Server used for the users of another domain",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain of the users, e.g. `dc=other,dc=com`",
+							Required:            true,
+						},
+						"server_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the LDAP server of the domain",
+							Required:            true,
+						},
+						"reader_dn": schema.StringAttribute{
+							MarkdownDescription: "The distinguished name of the account used to search the domain",
+							Required:            true,
+						},
+						"reader_password": schema.StringAttribute{
+							MarkdownDescription: "The password of the account used to search the domain",
+							Required:            true,
+							Sensitive:           true,
+						},
+						"start_tls": schema.BoolAttribute{
+							MarkdownDescription: "Upgrade the connection with StartTLS after connecting",
+							Optional:            true,
+						},
+						"tls_skip_verify": schema.BoolAttribute{
+							MarkdownDescription: "Skip the verification of the server certificate",
+							Optional:            true,
+						},
+						"no_simple_pagination": schema.BoolAttribute{
+							MarkdownDescription: "The server doesn't support the simple paged results control",
+							Optional:            true,
+						},
+						"root_certs": schema.StringAttribute{
+							MarkdownDescription: "The PEM encoded root certificates trusted for the server",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"user_search": schema.ListNestedBlock{
+				MarkdownDescription: "Search for the users synced with MSR, at least one is required",
+				Validators:          []validator.List{listvalidator.IsRequired(), listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base_dn": schema.StringAttribute{
+							MarkdownDescription: "The distinguished name the search starts from",
+							Required:            true,
+						},
+						"username_attr": schema.StringAttribute{
+							MarkdownDescription: "The attribute used as MSR user name, e.g. `uid` or `sAMAccountName`",
+							Required:            true,
+						},
+						"scope_subtree": schema.BoolAttribute{
+							MarkdownDescription: "Search the whole subtree instead of the direct children of `base_dn`",
+							Optional:            true,
+						},
+						"full_name_attr": schema.StringAttribute{
+							MarkdownDescription: "The attribute used as MSR full name, e.g. `cn`",
+							Optional:            true,
+						},
+						"filter": schema.StringAttribute{
+							MarkdownDescription: "The LDAP filter the users must match",
+							Optional:            true,
+						},
+						"match_group": schema.BoolAttribute{
+							MarkdownDescription: "Only sync the users that are members of `match_group_dn`",
+							Optional:            true,
+						},
+						"match_group_dn": schema.StringAttribute{
+							MarkdownDescription: "The distinguished name of the group the users must be members of",
+							Optional:            true,
+						},
+						"match_group_member_attr": schema.StringAttribute{
+							MarkdownDescription: "The attribute of the group listing its members, e.g. `member`",
+							Optional:            true,
+						},
+						"match_group_iterate": schema.BoolAttribute{
+							MarkdownDescription: "Look up the group members one by one, for groups too large to be searched",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *LDAPSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the LDAP settings, always `ldap`",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *LDAPSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LDAPSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *LDAPSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr ldap settings resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rSettings, err := r.updateSettings(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create ldap settings error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("configured LDAP server `%s`", rSettings.ServerURL))
+		data.fromLDAPSettings(rSettings)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *LDAPSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read ldap settings resource")
+	var data *LDAPSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr ldap settings resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.client.ReadLDAPSettings(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromLDAPSettings(rSettings)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *LDAPSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update ldap settings resource")
+
+	var data *LDAPSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr ldap settings resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rSettings, err := r.updateSettings(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromLDAPSettings(rSettings)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'ldap settings' resource", map[string]any{"success": true})
+}
+
+func (r *LDAPSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Users and teams may still rely on LDAP, the configuration is left in place.
+	tflog.Trace(ctx, "No action taken. ldap settings resource is removed from the state only.")
+}
+
+func (r *LDAPSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// identity returns the identity of the LDAP settings.
+// There is a single LDAP settings, the identity is the same in testing mode.
+func (m *LDAPSettingsResourceModel) identity() LDAPSettingsResourceIdentityModel {
+	return LDAPSettingsResourceIdentityModel{
+		Id: types.StringValue(ldapSettingsID),
+	}
+}
+
+// updateSettings replaces the LDAP settings of MSR with the model, keeping the admin sync options in place.
+func (r *LDAPSettingsResource) updateSettings(ctx context.Context, m *LDAPSettingsResourceModel) (client.LDAPSettings, error) {
+	current, err := r.client.ReadLDAPSettings(ctx)
+	if err != nil {
+		return client.LDAPSettings{}, err
+	}
+
+	settings := m.toLDAPSettings()
+	settings.AdminSyncOpts = current.AdminSyncOpts
+
+	return r.client.UpdateLDAPSettings(ctx, settings)
+}
+
+// toLDAPSettings converts the model to the enzi LDAP settings.
+func (m *LDAPSettingsResourceModel) toLDAPSettings() client.LDAPSettings {
+	settings := client.LDAPSettings{
+		LDAPDomain: client.LDAPDomain{
+			ServerURL:          m.ServerURL.ValueString(),
+			ReaderDN:           m.ReaderDN.ValueString(),
+			ReaderPassword:     m.ReaderPassword.ValueString(),
+			StartTLS:           m.StartTLS.ValueBool(),
+			TLSSkipVerify:      m.TLSSkipVerify.ValueBool(),
+			NoSimplePagination: m.NoSimplePagination.ValueBool(),
+			RootCerts:          m.RootCerts.ValueString(),
+		},
+		AdditionalDomains:   []client.LDAPDomain{},
+		UserSearchConfigs:   []client.LDAPUserSearchConfig{},
+		SyncSchedule:        m.SyncSchedule.ValueString(),
+		JitUserProvisioning: m.JitUserProvisioning.ValueBool(),
+	}
+
+	for _, d := range m.AdditionalDomains {
+		settings.AdditionalDomains = append(settings.AdditionalDomains, client.LDAPDomain{
+			Domain:             d.Domain.ValueString(),
+			ServerURL:          d.ServerURL.ValueString(),
+			ReaderDN:           d.ReaderDN.ValueString(),
+			ReaderPassword:     d.ReaderPassword.ValueString(),
+			StartTLS:           d.StartTLS.ValueBool(),
+			TLSSkipVerify:      d.TLSSkipVerify.ValueBool(),
+			NoSimplePagination: d.NoSimplePagination.ValueBool(),
+			RootCerts:          d.RootCerts.ValueString(),
+		})
+	}
+
+	for _, s := range m.UserSearches {
+		settings.UserSearchConfigs = append(settings.UserSearchConfigs, client.LDAPUserSearchConfig{
+			BaseDN:               s.BaseDN.ValueString(),
+			ScopeSubtree:         s.ScopeSubtree.ValueBool(),
+			UsernameAttr:         s.UsernameAttr.ValueString(),
+			FullNameAttr:         s.FullNameAttr.ValueString(),
+			Filter:               s.Filter.ValueString(),
+			MatchGroup:           s.MatchGroup.ValueBool(),
+			MatchGroupDN:         s.MatchGroupDN.ValueString(),
+			MatchGroupMemberAttr: s.MatchGroupMemberAttr.ValueString(),
+			MatchGroupIterate:    s.MatchGroupIterate.ValueBool(),
+		})
+	}
+
+	return settings
+}
+
+// fromLDAPSettings refreshes the model from the enzi LDAP settings.
+// The reader passwords enzi doesn't return keep their prior value.
+func (m *LDAPSettingsResourceModel) fromLDAPSettings(settings client.LDAPSettings) {
+	m.Id = types.StringValue(ldapSettingsID)
+	m.ServerURL = types.StringValue(settings.ServerURL)
+	m.ReaderDN = types.StringValue(settings.ReaderDN)
+	m.ReaderPassword = secretString(m.ReaderPassword, settings.ReaderPassword)
+	m.StartTLS = types.BoolValue(settings.StartTLS)
+	m.TLSSkipVerify = types.BoolValue(settings.TLSSkipVerify)
+	m.NoSimplePagination = types.BoolValue(settings.NoSimplePagination)
+	m.RootCerts = types.StringValue(settings.RootCerts)
+	m.SyncSchedule = types.StringValue(settings.SyncSchedule)
+	m.JitUserProvisioning = types.BoolValue(settings.JitUserProvisioning)
+
+	priorDomains := m.AdditionalDomains
+	m.AdditionalDomains = nil
+	for i, d := range settings.AdditionalDomains {
+		p := ldapDomainModel{}
+		if i < len(priorDomains) {
+			p = priorDomains[i]
+		}
+		m.AdditionalDomains = append(m.AdditionalDomains, ldapDomainModel{
+			Domain:             types.StringValue(d.Domain),
+			ServerURL:          types.StringValue(d.ServerURL),
+			ReaderDN:           types.StringValue(d.ReaderDN),
+			ReaderPassword:     secretString(p.ReaderPassword, d.ReaderPassword),
+			StartTLS:           optionalBool(p.StartTLS, d.StartTLS),
+			TLSSkipVerify:      optionalBool(p.TLSSkipVerify, d.TLSSkipVerify),
+			NoSimplePagination: optionalBool(p.NoSimplePagination, d.NoSimplePagination),
+			RootCerts:          optionalString(p.RootCerts, d.RootCerts),
+		})
+	}
+
+	priorSearches := m.UserSearches
+	m.UserSearches = nil
+	for i, s := range settings.UserSearchConfigs {
+		p := ldapUserSearchModel{}
+		if i < len(priorSearches) {
+			p = priorSearches[i]
+		}
+		m.UserSearches = append(m.UserSearches, ldapUserSearchModel{
+			BaseDN:               types.StringValue(s.BaseDN),
+			ScopeSubtree:         optionalBool(p.ScopeSubtree, s.ScopeSubtree),
+			UsernameAttr:         types.StringValue(s.UsernameAttr),
+			FullNameAttr:         optionalString(p.FullNameAttr, s.FullNameAttr),
+			Filter:               optionalString(p.Filter, s.Filter),
+			MatchGroup:           optionalBool(p.MatchGroup, s.MatchGroup),
+			MatchGroupDN:         optionalString(p.MatchGroupDN, s.MatchGroupDN),
+			MatchGroupMemberAttr: optionalString(p.MatchGroupMemberAttr, s.MatchGroupMemberAttr),
+			MatchGroupIterate:    optionalBool(p.MatchGroupIterate, s.MatchGroupIterate),
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLDAPSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// At least one user search is required
+			{
+				Config: providerConfig + `
+				resource "msr_ldap_settings" "test" {
+					server_url      = "ldaps://ldap.example.com"
+					reader_dn       = "cn=reader,dc=example,dc=com"
+					reader_password = "secret"
+				}`,
+				ExpectError: regexp.MustCompile("user_search"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testLDAPSettingsResource("@hourly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "server_url", "ldaps://ldap.example.com"),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "start_tls", "false"),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "jit_user_provisioning", "true"),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "additional_domain.#", "1"),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "additional_domain.0.domain", "dc=other,dc=com"),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "user_search.#", "1"),
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "user_search.0.username_attr", "sAMAccountName"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testLDAPSettingsResource("0 */30 * * * *"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_ldap_settings.test", "sync_schedule", "0 */30 * * * *"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testLDAPSettingsResource(schedule string) string {
+	return `
+	resource "msr_ldap_settings" "test" {
+		server_url      = "ldaps://ldap.example.com"
+		reader_dn       = "cn=reader,dc=example,dc=com"
+		reader_password = "secret"
+		sync_schedule   = "` + schedule + `"
+
+		additional_domain {
+			domain          = "dc=other,dc=com"
+			server_url      = "ldaps://ldap.other.com"
+			reader_dn       = "cn=reader,dc=other,dc=com"
+			reader_password = "other"
+		}
+
+		user_search {
+			base_dn        = "ou=people,dc=example,dc=com"
+			username_attr  = "sAMAccountName"
+			full_name_attr = "cn"
+			scope_subtree  = true
+		}
+	}`
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource             = &LDAPSyncRunResource{}
+	_ resource.ResourceWithIdentity = &LDAPSyncRunResource{}
+)
+
+type LDAPSyncRunResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Status            types.String   `tfsdk:"status"`
+	WorkerID          types.String   `tfsdk:"worker_id"`
+	ScheduledAt       types.String   `tfsdk:"scheduled_at"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// LDAPSyncRunResourceIdentityModel identifies a run by the ID of its job.
+type LDAPSyncRunResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type LDAPSyncRunResource struct {
+	client client.Client
+}
+
+func NewLDAPSyncRunResource() resource.Resource {
+	return &LDAPSyncRunResource{}
+}
+
+func (r *LDAPSyncRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_sync_run"
+}
+
+func (r *LDAPSyncRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Triggers an immediate LDAP sync of the users and teams, besides the `msr_ldap_settings` sync schedule. A new sync runs whenever the resource is replaced, e.g. when `triggers` change",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sync job identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause a new sync to run when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the sync job to finish before completing the apply",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the sync job",
+				Computed:            true,
+			},
+			"worker_id": schema.StringAttribute{
+				MarkdownDescription: "The worker running the sync job",
+				Computed:            true,
+			},
+			"scheduled_at": schema.StringAttribute{
+				MarkdownDescription: "The time the sync job was scheduled at",
+				Computed:            true,
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "The time the sync job was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *LDAPSyncRunResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the LDAP sync job",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *LDAPSyncRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LDAPSyncRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *LDAPSyncRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr ldap sync run resource handler is in testing mode, no creation will be run.")
+		data.fromJob(client.ResponseJob{
+			ID:          TestingVersion,
+			Status:      client.JobDone,
+			WorkerID:    TestingVersion,
+			ScheduledAt: TestingVersion,
+			LastUpdated: TestingVersion,
+		})
+	} else {
+		rJob, err := r.client.CreateLDAPSyncJob(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create ldap sync run error",
+				err.Error(),
+			)
+			return
+		}
+		tflog.Trace(ctx, fmt.Sprintf("created ldap sync job `%s`", rJob.ID))
+
+		if data.WaitForCompletion.ValueBool() {
+			finishedJob, err := r.client.WaitForEnziJob(ctx, rJob.ID, client.DefaultJobPollInterval)
+			if err != nil {
+				// Keep the job in state so that it gets tainted and run again on the next apply
+				if finishedJob.ID != "" {
+					rJob = finishedJob
+				}
+				data.fromJob(rJob)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+				resp.Diagnostics.AddError(
+					"LDAP sync did not complete",
+					err.Error(),
+				)
+				return
+			}
+			rJob = finishedJob
+		}
+
+		data.fromJob(rJob)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *LDAPSyncRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read ldap sync run resource")
+	var data *LDAPSyncRunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr ldap sync run resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rJob, err := r.client.ReadEnziJob(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromJob(rJob)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *LDAPSyncRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute triggering a sync requires replacement,
+	// the remaining ones only affect how the next run is handled.
+	var data *LDAPSyncRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *LDAPSyncRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Finished jobs can't be removed from MSR, the run is only dropped from the state.
+	tflog.Trace(ctx, "No action taken. ldap sync run resource is removed from the state only.")
+}
+
+func (r *LDAPSyncRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), true)...)
+}
+
+// identity returns the identity of the run.
+func (m *LDAPSyncRunResourceModel) identity() LDAPSyncRunResourceIdentityModel {
+	return LDAPSyncRunResourceIdentityModel{
+		Id: m.Id,
+	}
+}
+
+// fromJob refreshes the model from the LDAP sync job.
+func (m *LDAPSyncRunResourceModel) fromJob(job client.ResponseJob) {
+	m.Id = types.StringValue(job.ID)
+	m.Status = types.StringValue(job.Status)
+	m.WorkerID = types.StringValue(job.WorkerID)
+	m.ScheduledAt = types.StringValue(job.ScheduledAt)
+	m.LastUpdated = types.StringValue(job.LastUpdated)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLDAPSyncRunResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testLDAPSyncRunResource("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_ldap_sync_run.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_ldap_sync_run.test", "status", client.JobDone),
+					resource.TestCheckResourceAttr("msr_ldap_sync_run.test", "wait_for_completion", "true"),
+					resource.TestCheckResourceAttrSet("msr_ldap_sync_run.test", "scheduled_at"),
+				),
+			},
+			// Replace testing
+			{
+				Config: providerConfig + testLDAPSyncRunResource("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_ldap_sync_run.test", "triggers.release", "2"),
+					resource.TestCheckResourceAttr("msr_ldap_sync_run.test", "status", client.JobDone),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testLDAPSyncRunResource(release string) string {
+	return `
+	resource "msr_ldap_sync_run" "test" {
+		triggers = {
+			release = "` + release + `"
+		}
+	}`
+}
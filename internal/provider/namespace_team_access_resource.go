@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &NamespaceTeamAccessResource{}
+	_ resource.ResourceWithImportState = &NamespaceTeamAccessResource{}
+	_ resource.ResourceWithIdentity    = &NamespaceTeamAccessResource{}
+)
+
+type NamespaceTeamAccessResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	OrgName     types.String   `tfsdk:"org_name"`
+	TeamName    types.String   `tfsdk:"team_name"`
+	AccessLevel types.String   `tfsdk:"access_level"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NamespaceTeamAccessResourceIdentityModel identifies the access of a team by its organization and name.
+type NamespaceTeamAccessResourceIdentityModel struct {
+	OrgName  types.String `tfsdk:"org_name"`
+	TeamName types.String `tfsdk:"team_name"`
+}
+
+type NamespaceTeamAccessResource struct {
+	client client.Client
+}
+
+func NewNamespaceTeamAccessResource() resource.Resource {
+	return &NamespaceTeamAccessResource{}
+}
+
+func (r *NamespaceTeamAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_team_access"
+}
+
+func (r *NamespaceTeamAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Namespace team access resource. Grants a team access to all the repos of an organization, including the repos created later",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "The organization namespace to grant access to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_name": schema.StringAttribute{
+				MarkdownDescription: "The team of the organization granted access",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_level": schema.StringAttribute{
+				MarkdownDescription: "The access level granted, one of `read-only`, `read-write` or `admin`",
+				Required:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					client.RepoAccessReadOnly, client.RepoAccessReadWrite, client.RepoAccessAdmin,
+				)},
+			},
+		},
+	}
+}
+
+func (r *NamespaceTeamAccessResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_name": identityschema.StringAttribute{
+				Description:       "The name of the organization",
+				RequiredForImport: true,
+			},
+			"team_name": identityschema.StringAttribute{
+				Description:       "The name of the team",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *NamespaceTeamAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NamespaceTeamAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NamespaceTeamAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr namespace team access resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rAccess, err := r.client.UpdateNamespaceTeamAccess(ctx, data.OrgName.ValueString(), data.TeamName.ValueString(), data.AccessLevel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create namespace team access error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("granted team `%s` access to namespace `%s`", data.TeamName.ValueString(), data.OrgName.ValueString()))
+		data.Id = basetypes.NewStringValue(data.OrgName.ValueString() + "," + data.TeamName.ValueString())
+		data.AccessLevel = basetypes.NewStringValue(rAccess.AccessLevel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *NamespaceTeamAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read namespace team access resource")
+	var data *NamespaceTeamAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr namespace team access resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rAccess, err := r.client.ReadNamespaceTeamAccess(ctx, data.OrgName.ValueString(), data.TeamName.ValueString())
+		if errors.Is(err, client.ErrNotFound) {
+			// The access was revoked outside of Terraform, plan granting it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.Id = types.StringValue(data.OrgName.ValueString() + "," + data.TeamName.ValueString())
+		data.AccessLevel = types.StringValue(rAccess.AccessLevel)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *NamespaceTeamAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update namespace team access resource")
+
+	var data *NamespaceTeamAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr namespace team access resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rAccess, err := r.client.UpdateNamespaceTeamAccess(ctx, data.OrgName.ValueString(), data.TeamName.ValueString(), data.AccessLevel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.AccessLevel = types.StringValue(rAccess.AccessLevel)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'namespace team access' resource", map[string]any{"success": true})
+}
+
+func (r *NamespaceTeamAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NamespaceTeamAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr namespace team access resource handler is in testing mode, no deletion will be run.")
+	} else if err := r.client.DeleteNamespaceTeamAccess(ctx, data.OrgName.ValueString(), data.TeamName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted namespace team access resource", map[string]any{"success": true})
+}
+
+func (r *NamespaceTeamAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity NamespaceTeamAccessResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), identity.OrgName)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_name"), identity.TeamName)...)
+		return
+	}
+
+	idParts := splitImportID(req.ID)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.Append(importIDError("org_name/team_name", req.ID)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_name"), idParts[1])...)
+}
+
+// identity returns the identity of the team access.
+func (m *NamespaceTeamAccessResourceModel) identity() NamespaceTeamAccessResourceIdentityModel {
+	return NamespaceTeamAccessResourceIdentityModel{
+		OrgName:  m.OrgName,
+		TeamName: m.TeamName,
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNamespaceTeamAccessResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time access level validation
+			{
+				Config:      providerConfig + testNamespaceTeamAccessResource("write"),
+				ExpectError: regexp.MustCompile("access_level"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testNamespaceTeamAccessResource("read-only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_namespace_team_access.test", "org_name", "test"),
+					resource.TestCheckResourceAttr("msr_namespace_team_access.test", "team_name", "devs"),
+					resource.TestCheckResourceAttr("msr_namespace_team_access.test", "access_level", "read-only"),
+					resource.TestCheckResourceAttr("msr_namespace_team_access.test", "id", TestingVersion),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "msr_namespace_team_access.test",
+				ImportStateId: "test/devs",
+				ImportState:   true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testNamespaceTeamAccessResource("read-write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_namespace_team_access.test", "access_level", "read-write"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testNamespaceTeamAccessResource(accessLevel string) string {
+	return `
+	resource "msr_namespace_team_access" "test" {
+		org_name     = "test"
+		team_name    = "devs"
+		access_level = "` + accessLevel + `"
+	}`
+}
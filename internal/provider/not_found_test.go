@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testReadNotFound refreshes the prior state of an object the fake MSR doesn't hold, out of testing mode,
+// and returns the prior and the refreshed states.
+func testReadNotFound(t *testing.T, server *httptest.Server, r resource.Resource, prior any) (tfsdk.State, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+
+	c, err := client.NewClient("admin", "admin", server.URL, false, server.Client())
+	if err != nil {
+		t.Fatalf("creating the client: %s", err)
+	}
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configuring the resource: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, prior); diags.HasError() {
+		t.Fatalf("setting the prior state: %v", diags)
+	}
+
+	req := resource.ReadRequest{State: state}
+	resp := resource.ReadResponse{State: state}
+	if rWithIdentity, ok := r.(resource.ResourceWithIdentity); ok {
+		identitySchemaResp := resource.IdentitySchemaResponse{}
+		rWithIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+		req.Identity = &tfsdk.ResourceIdentity{
+			Schema: identitySchemaResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+		resp.Identity = req.Identity
+	}
+	r.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+
+	return state, resp.State
+}
+
+// testReadRemoved fails unless reading an object the fake MSR doesn't hold removes the resource from the state.
+func testReadRemoved(t *testing.T, server *httptest.Server, r resource.Resource, prior any) {
+	t.Helper()
+
+	if _, state := testReadNotFound(t, server, r, prior); !state.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from the state, got %s", state.Raw)
+	}
+}
+
+func TestReadRemovesDeletedResources(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	t.Run("namespace team access", func(t *testing.T) {
+		testReadRemoved(t, server, NewNamespaceTeamAccessResource(), NamespaceTeamAccessResourceModel{
+			Id:          types.StringValue("acme,ops"),
+			Timeouts:    nullTimeouts(),
+			OrgName:     types.StringValue("acme"),
+			TeamName:    types.StringValue("ops"),
+			AccessLevel: types.StringValue("read-only"),
+		})
+	})
+
+	t.Run("repo user access", func(t *testing.T) {
+		testReadRemoved(t, server, NewRepoUserAccessResource(), RepoUserAccessResourceModel{
+			Id:          types.StringValue("acme,app,bob"),
+			Timeouts:    nullTimeouts(),
+			Namespace:   types.StringValue("acme"),
+			RepoName:    types.StringValue("app"),
+			Username:    types.StringValue("bob"),
+			AccessLevel: types.StringValue("read-only"),
+		})
+	})
+}
+
+func TestGCRunReadKeepsPrunedJobs(t *testing.T) {
+	server := newTestMSRServer(t)
+
+	// MSR prunes the finished jobs, the run is kept as last read
+	prior, state := testReadNotFound(t, server, NewGCRunResource(), GCRunResourceModel{
+		Id: types.StringValue("j-1"),
+		// The run only has create and read timeouts
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+		})},
+		Triggers:          types.MapNull(types.StringType),
+		WaitForCompletion: types.BoolValue(true),
+		Status:            types.StringValue("done"),
+		WorkerID:          types.StringValue("w-1"),
+		ScheduledAt:       types.StringValue("2024-01-01T00:00:00Z"),
+		LastUpdated:       types.StringValue("2024-01-01T00:10:00Z"),
+	})
+	if !state.Raw.Equal(prior.Raw) {
+		t.Errorf("expected the run to be kept as %s, got %s", prior.Raw, state.Raw)
+	}
+}
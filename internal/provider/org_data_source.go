@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &orgDataSource{}
+)
+
+func NewOrgDataSource() datasource.DataSource {
+	return &orgDataSource{}
+}
+
+type orgDataSource struct {
+	client client.Client
+}
+
+// orgDataSourceModel maps the data source schema data.
+type orgDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	IsImported   types.Bool   `tfsdk:"is_imported"`
+	OnDemand     types.Bool   `tfsdk:"on_demand"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+	TeamsCount   types.Int64  `tfsdk:"teams_count"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *orgDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *orgDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org"
+}
+
+func (d *orgDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Org data source. Looks up an organization by name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization",
+				Required:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the organization",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Is the organization active",
+				Computed:            true,
+			},
+			"is_imported": schema.BoolAttribute{
+				MarkdownDescription: "Is the organization imported from LDAP",
+				Computed:            true,
+			},
+			"on_demand": schema.BoolAttribute{
+				MarkdownDescription: "Is the organization on demand",
+				Computed:            true,
+			},
+			"members_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of members of the organization",
+				Computed:            true,
+			},
+			"teams_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of teams of the organization",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *orgDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read org data source")
+	var data orgDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr org datasource handler is in testing mode, no injestion will be run.")
+		data.fromAccount(client.ResponseAccount{ID: TestingVersion, Name: data.Name.ValueString(), IsOrg: true, IsActive: true})
+	} else {
+		rAcc, err := d.client.ReadAccount(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Org",
+				err.Error(),
+			)
+			return
+		}
+		if !rAcc.IsOrg {
+			resp.Diagnostics.AddError(
+				"Unable to Read Org",
+				fmt.Sprintf("account `%s` is not an organization", rAcc.Name),
+			)
+			return
+		}
+		data.fromAccount(rAcc)
+
+		tflog.Trace(ctx, fmt.Sprintf("read in org data source `%s`", rAcc.ID))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading org data source", map[string]any{"success": true})
+}
+
+// fromAccount fills the model from the organization account.
+func (m *orgDataSourceModel) fromAccount(acc client.ResponseAccount) {
+	m.ID = types.StringValue(acc.ID)
+	m.Name = types.StringValue(acc.Name)
+	m.FullName = types.StringValue(acc.FullName)
+	m.IsActive = types.BoolValue(acc.IsActive)
+	m.IsImported = types.BoolValue(acc.IsImported)
+	m.OnDemand = types.BoolValue(acc.OnDemand)
+	m.MembersCount = types.Int64Value(int64(acc.MembersCount))
+	m.TeamsCount = types.Int64Value(int64(acc.TeamsCount))
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestOrgDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_org" "test" {
+					name = "example"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_org.test", "name", "example"),
+					resource.TestCheckResourceAttr("data.msr_org.test", "is_active", "true"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.msr_org.test", "id", TestingVersion),
+				),
+			},
+		},
+	})
+}
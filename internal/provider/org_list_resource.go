@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &OrgListResource{}
+	_ list.ListResourceWithConfigure = &OrgListResource{}
+)
+
+type OrgListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// OrgListResource lists the organizations of MSR for `terraform query`.
+type OrgListResource struct {
+	client client.Client
+}
+
+func NewOrgListResource() list.ListResource {
+	return &OrgListResource{}
+}
+
+func (r *OrgListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org"
+}
+
+func (r *OrgListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the organizations, to import them",
+
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listschema.StringAttribute{
+				MarkdownDescription: "Only list the organizations whose name matches the regular expression",
+				Optional:            true,
+				Validators:          []validator.String{regexValidator{}},
+			},
+		},
+	}
+}
+
+func (r *OrgListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrgListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data OrgListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var accs []client.ResponseAccount
+	if r.client.TestMode {
+		diags.AddWarning("testing mode warning", "msr org list resource handler is in testing mode, no listing will be run.")
+		accs = []client.ResponseAccount{{ID: TestingVersion, Name: TestingVersion, IsOrg: true}}
+	} else {
+		var err error
+		accs, err = r.client.ReadAccounts(ctx, client.Orgs)
+		if err != nil {
+			diags.AddError("Unable to List Orgs", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	nameRegex := listNameRegex(data.NameRegex)
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for _, acc := range accs {
+			if listLimitReached(req, count) {
+				return
+			}
+			if nameRegex != nil && !nameRegex.MatchString(acc.Name) {
+				continue
+			}
+
+			model := OrgResourceModel{
+				Id:                 types.StringValue(acc.ID),
+				Timeouts:           nullTimeouts(),
+				Name:               types.StringValue(acc.Name),
+				DeletionProtection: types.BoolValue(false),
+				OnDestroy:          types.StringValue(onDestroyDelete),
+			}
+
+			result := newListResult(ctx, req, acc.Name, model.identity(), model)
+			result.Diagnostics.Append(diags...)
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
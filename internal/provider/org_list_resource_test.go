@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOrgListResource(t *testing.T) {
+	ctx := context.Background()
+	server := newTestMSRServer(t)
+
+	// Users aren't listed
+	results := testListResults(t, server, NewOrgListResource(), NewOrgResource(), nil, true, 0)
+	if len(results) != 1 || results[0].DisplayName != "acme" {
+		t.Fatalf("expected only acme, got %d results", len(results))
+	}
+
+	var identity OrgResourceIdentityModel
+	if diags := results[0].Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("reading the identity: %v", diags)
+	}
+	if identity.Name.ValueString() != "acme" {
+		t.Errorf("expected the acme identity, got %s", identity.Name)
+	}
+
+	var model OrgResourceModel
+	if diags := results[0].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading the resource: %v", diags)
+	}
+	if model.Id.ValueString() != "o-1" {
+		t.Errorf("unexpected resource %+v", model)
+	}
+
+	results = testListResults(t, server, NewOrgListResource(), NewOrgResource(), map[string]string{"name_regex": "^other"}, false, 0)
+	if len(results) != 0 {
+		t.Errorf("expected no organization, got %d results", len(results))
+	}
+}
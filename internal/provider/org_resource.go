@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -15,11 +17,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &OrgResource{}
+var (
+	_ resource.Resource                 = &OrgResource{}
+	_ resource.ResourceWithModifyPlan   = &OrgResource{}
+	_ resource.ResourceWithIdentity     = &OrgResource{}
+	_ resource.ResourceWithUpgradeState = &OrgResource{}
+)
 
 type OrgResourceModel struct {
+	Name               types.String   `tfsdk:"name"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	OnDestroy          types.String   `tfsdk:"on_destroy"`
+	Id                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// OrgResourceIdentityModel identifies an organization by its name.
+type OrgResourceIdentityModel struct {
 	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
 }
 
 type OrgResource struct {
@@ -36,8 +51,13 @@ func (r *OrgResource) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *OrgResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -47,14 +67,87 @@ func (r *OrgResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the organization",
+				MarkdownDescription: "The name of the organization. MSR can't rename orgs, changing it replaces the org and deletes its teams",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
+			"deletion_protection": deletionProtectionAttribute(),
+			"on_destroy":          onDestroyAttribute(),
 		},
 		MarkdownDescription: "Organzation resource",
 	}
 }
 
+// orgResourceModelV0 is the org state before deletion_protection and on_destroy.
+type orgResourceModelV0 struct {
+	Name types.String `tfsdk:"name"`
+	Id   types.String `tfsdk:"id"`
+}
+
+func (r *OrgResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 adds deletion_protection and on_destroy
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Required: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior orgResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, OrgResourceModel{
+					Name:               prior.Name,
+					DeletionProtection: types.BoolValue(false),
+					OnDestroy:          types.StringValue(onDestroyDelete),
+					Id:                 prior.Id,
+					Timeouts:           nullTimeouts(),
+				})...)
+			},
+		},
+	}
+}
+
+func (r *OrgResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the organization",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *OrgResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to warn about on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *OrgResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Name.Equal(plan.Name) {
+		resp.Diagnostics.AddWarning(
+			"Org replacement",
+			fmt.Sprintf("MSR can't rename orgs. Org %s will be deleted along with its teams, their members and their permissions, and %s created empty.",
+				state.Name.ValueString(), plan.Name.ValueString()),
+		)
+	}
+}
+
 func (r *OrgResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -84,9 +177,17 @@ func (r *OrgResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, orgData.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	acc := client.CreateAccount{
-		Name:  orgData.Name.ValueString(),
-		IsOrg: true,
+		Name:     orgData.Name.ValueString(),
+		IsActive: true,
+		IsOrg:    true,
 	}
 
 	if r.client.TestMode {
@@ -108,6 +209,7 @@ func (r *OrgResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &orgData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, orgData.identity())...)
 }
 
 func (r *OrgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -122,6 +224,13 @@ func (r *OrgResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr org resource handler is in testing mode, no read will be run.")
 		data.Id = types.StringValue(TestingVersion)
@@ -137,11 +246,31 @@ func (r *OrgResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *OrgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Can't really update Org resource
+	var data *OrgResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Can't really update Org resource, only the provider side attributes are saved
 	tflog.Trace(ctx, "No action taken. Org resourcs can't be updated.")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *OrgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -150,6 +279,23 @@ func (r *OrgResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteOrg, diags := checkDestroy(ctx, "org", data.Name.ValueString(), data.DeletionProtection, data.OnDestroy)
+	resp.Diagnostics.Append(diags...)
+	if !deleteOrg {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr org resource handler is in testing mode, no deletion will be run.")
 	} else if err := r.client.DeleteAccount(ctx, data.Name.ValueString()); err != nil {
@@ -159,5 +305,11 @@ func (r *OrgResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *OrgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+	resp.Diagnostics.Append(importDestroyDefaults(ctx, &resp.State)...)
+}
+
+// identity returns the identity of the organization.
+func (m *OrgResourceModel) identity() OrgResourceIdentityModel {
+	return OrgResourceIdentityModel{Name: m.Name}
 }
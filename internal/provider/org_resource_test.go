@@ -1,15 +1,25 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestOrgResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Resource identities need Terraform 1.12
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -17,8 +27,15 @@ func TestOrgResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("msr_org.test", "name", TestingVersion),
 					resource.TestCheckResourceAttr("msr_org.test", "id", TestingVersion),
+					resource.TestCheckResourceAttr("msr_org.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("msr_org.test", "on_destroy", "delete"),
 					resource.TestCheckResourceAttrSet("msr_org.test", "id"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("msr_org.test", map[string]knownvalue.Check{
+						"name": knownvalue.StringExact(TestingVersion),
+					}),
+				},
 			},
 			// ImportState testing
 			{
@@ -26,12 +43,77 @@ func TestOrgResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
-			// No Update for the org resource
+			// ImportState testing with the identity
+			{
+				Config:          providerConfig + testOrgResource(),
+				ResourceName:    "msr_org.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Renaming replaces the org
+			{
+				Config: providerConfig + `
+				resource "msr_org" "test" {
+					name = "blah"
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_org.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_org.test", "name", "blah"),
+				),
+				// The identity follows the name
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("msr_org.test", map[string]knownvalue.Check{
+						"name": knownvalue.StringExact("blah"),
+					}),
+				},
+			},
 			// Delete is called implicitly
 		},
 	})
 }
 
+func TestOrgResourceDeletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a protected org
+			{
+				Config: providerConfig + testOrgResourceProtected(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_org.test", "deletion_protection", "true"),
+				),
+			},
+			// Destroying the protected org fails
+			{
+				Config:      providerConfig + testOrgResourceProtected(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
+			// Lift the protection in a prior apply
+			{
+				Config: providerConfig + testOrgResourceProtected(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_org.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testOrgResourceProtected(protected bool) string {
+	return fmt.Sprintf(`
+	resource "msr_org" "test" {
+		name                = "test"
+		deletion_protection = %t
+	}`, protected)
+}
+
 func testOrgResource() string {
 	return `
 	resource "msr_org" "test" {
@@ -5,6 +5,8 @@ import (
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,11 +14,17 @@ import (
 )
 
 const (
+	// TestingVersion stands for the values read from MSR in testing mode.
+	// The identities are still built from the model, so they hold it in place of the MSR IDs.
 	TestingVersion = "test"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
-var _ provider.Provider = &MSRProvider{}
+var (
+	_ provider.Provider                       = &MSRProvider{}
+	_ provider.ProviderWithEphemeralResources = &MSRProvider{}
+	_ provider.ProviderWithListResources      = &MSRProvider{}
+)
 
 // MSRProvider defines the provider implementation.
 type MSRProvider struct {
@@ -103,6 +111,8 @@ func (p *MSRProvider) Configure(ctx context.Context, req provider.ConfigureReque
 
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
 }
 
 func (p *MSRProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -112,6 +122,18 @@ func (p *MSRProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewTeamResource,
 		NewRepoResource,
 		NewPruningPolicyResource,
+		NewGCScheduleResource,
+		NewGCRunResource,
+		NewStorageBackendResource,
+		NewRepoSigningPolicyResource,
+		NewHelmChartResource,
+		NewRepoUserAccessResource,
+		NewNamespaceTeamAccessResource,
+		NewAccessTokenResource,
+		NewLDAPSettingsResource,
+		NewLDAPSyncRunResource,
+		NewAuthSAMLResource,
+		NewAuthOIDCResource,
 	}
 }
 
@@ -119,6 +141,34 @@ func (p *MSRProvider) DataSources(ctx context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		NewAccountDataSource,
 		NewaccountsDataSource,
+		NewJobsDataSource,
+		NewCronsDataSource,
+		NewRepoSignedTagsDataSource,
+		NewHelmChartsDataSource,
+		NewRepoCollaboratorsDataSource,
+		NewOrgDataSource,
+		NewTeamDataSource,
+		NewRepoDataSource,
+		NewUserDataSource,
+		NewReposDataSource,
+		NewTeamsDataSource,
+	}
+}
+
+func (p *MSRProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewGeneratedPasswordEphemeralResource,
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *MSRProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewRepoListResource,
+		NewTeamListResource,
+		NewUserListResource,
+		NewOrgListResource,
+		NewPruningPolicyListResource,
 	}
 }
 
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &PruningPolicyListResource{}
+	_ list.ListResourceWithConfigure = &PruningPolicyListResource{}
+)
+
+type PruningPolicyListResourceModel struct {
+	OrgName  types.String `tfsdk:"org_name"`
+	RepoName types.String `tfsdk:"repo_name"`
+}
+
+// PruningPolicyListResource lists the pruning policies of the repos for `terraform query`.
+type PruningPolicyListResource struct {
+	client client.Client
+}
+
+func NewPruningPolicyListResource() list.ListResource {
+	return &PruningPolicyListResource{}
+}
+
+func (r *PruningPolicyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pruning_policy"
+}
+
+func (r *PruningPolicyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the pruning policies of the repos, to import them. " +
+			"The policies of each repo are one more request",
+
+		Attributes: map[string]listschema.Attribute{
+			"org_name": listschema.StringAttribute{
+				MarkdownDescription: "Only list the policies of the repos of the organization. The policies of all the repos are listed when unset",
+				Optional:            true,
+			},
+			"repo_name": listschema.StringAttribute{
+				MarkdownDescription: "Only list the policies of the repository of the organization",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("org_name")),
+				},
+			},
+		},
+	}
+}
+
+func (r *PruningPolicyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PruningPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PruningPolicyListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var repos []client.ResponseRepo
+	switch {
+	case r.client.TestMode:
+		diags.AddWarning("testing mode warning", "msr pruning policy list resource handler is in testing mode, no listing will be run.")
+		repos = []client.ResponseRepo{{Name: TestingVersion, Namespace: TestingVersion}}
+	case data.RepoName.ValueString() != "":
+		repos = []client.ResponseRepo{{Name: data.RepoName.ValueString(), Namespace: data.OrgName.ValueString()}}
+	default:
+		var err error
+		repos, err = r.client.ReadRepos(ctx, data.OrgName.ValueString())
+		if err != nil {
+			diags.AddError("Unable to List Pruning Policies", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for _, repo := range repos {
+			policies := []client.ResponsePruningPolicy{{ID: TestingVersion, Enabled: true}}
+			if !r.client.TestMode {
+				var err error
+				policies, err = r.client.ReadPruningPolicies(ctx, repo.Namespace, repo.Name)
+				if err != nil {
+					var errDiags diag.Diagnostics
+					errDiags.AddError("Unable to List Pruning Policies", err.Error())
+					push(list.ListResult{Diagnostics: errDiags})
+					return
+				}
+			}
+
+			for _, policy := range policies {
+				if listLimitReached(req, count) {
+					return
+				}
+
+				model := PruningPolicyResourceModel{
+					OrgName:  types.StringValue(repo.Namespace),
+					RepoName: types.StringValue(repo.Name),
+					Timeouts: nullTimeouts(),
+				}
+				model.fromPruningPolicy(ctx, policy)
+
+				displayName := fmt.Sprintf("%s/%s/%s", repo.Namespace, repo.Name, policy.ID)
+				result := newListResult(ctx, req, displayName, model.identity(), model)
+				result.Diagnostics.Append(diags...)
+				if !push(result) {
+					return
+				}
+				count++
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPruningPolicyListResource(t *testing.T) {
+	ctx := context.Background()
+	server := newTestMSRServer(t)
+
+	// Only acme/app has a policy
+	results := testListResults(t, server, NewPruningPolicyListResource(), NewPruningPolicyResource(), nil, true, 0)
+	if len(results) != 1 || results[0].DisplayName != "acme/app/p-1" {
+		t.Fatalf("expected only acme/app/p-1, got %d results", len(results))
+	}
+
+	var identity PruningPolicyResourceIdentityModel
+	if diags := results[0].Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("reading the identity: %v", diags)
+	}
+	if identity.OrgName.ValueString() != "acme" || identity.RepoName.ValueString() != "app" || identity.PolicyID.ValueString() != "p-1" {
+		t.Errorf("expected the acme/app/p-1 identity, got %s/%s/%s", identity.OrgName, identity.RepoName, identity.PolicyID)
+	}
+
+	var model PruningPolicyResourceModel
+	if diags := results[0].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading the resource: %v", diags)
+	}
+	if !model.Enabled.ValueBool() || len(model.Rules) != 1 || model.Rules[0].Field.ValueString() != "tag" {
+		t.Errorf("unexpected resource %+v", model)
+	}
+
+	results = testListResults(t, server, NewPruningPolicyListResource(), NewPruningPolicyResource(), map[string]string{"org_name": "acme", "repo_name": "lib"}, false, 0)
+	if len(results) != 0 {
+		t.Errorf("expected no policy, got %d results", len(results))
+	}
+}
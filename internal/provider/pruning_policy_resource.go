@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
-	"time"
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &PruningPolicyResource{}
+var (
+	_ resource.Resource             = &PruningPolicyResource{}
+	_ resource.ResourceWithIdentity = &PruningPolicyResource{}
+)
 
 type PruningPolicyResourceModel struct {
 	Id       types.String                    `tfsdk:"id"`
@@ -26,6 +30,14 @@ type PruningPolicyResourceModel struct {
 	OrgName  types.String                    `tfsdk:"org_name"`
 	RepoName types.String                    `tfsdk:"repo_name"`
 	Rules    []client.PruningPolicyRuleTFSDK `tfsdk:"rule"`
+	Timeouts timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// PruningPolicyResourceIdentityModel identifies a pruning policy by its repo and ID.
+type PruningPolicyResourceIdentityModel struct {
+	OrgName  types.String `tfsdk:"org_name"`
+	RepoName types.String `tfsdk:"repo_name"`
+	PolicyID types.String `tfsdk:"policy_id"`
 }
 
 type PruningPolicyResource struct {
@@ -43,6 +55,7 @@ func (r *PruningPolicyResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *PruningPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 0,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Pruning policy resource",
 
@@ -61,16 +74,23 @@ func (r *PruningPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				Default:             booldefault.StaticBool(true),
 			},
 			"org_name": schema.StringAttribute{
-				MarkdownDescription: "The organization that contains the repo",
+				MarkdownDescription: "The organization that contains the repo. Changing it replaces the pruning policy",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"repo_name": schema.StringAttribute{
-				MarkdownDescription: "The repository to apply the pruning policy on",
+				MarkdownDescription: "The repository to apply the pruning policy on. Changing it replaces the pruning policy",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 			"rule": schema.ListNestedBlock{
 				MarkdownDescription: "The rules of the pruning policy",
 				NestedObject: schema.NestedBlockObject{
@@ -96,6 +116,25 @@ func (r *PruningPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *PruningPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_name": identityschema.StringAttribute{
+				Description:       "The organization that contains the repo",
+				RequiredForImport: true,
+			},
+			"repo_name": identityschema.StringAttribute{
+				Description:       "The repository the pruning policy applies on",
+				RequiredForImport: true,
+			},
+			"policy_id": identityschema.StringAttribute{
+				Description:       "The ID of the pruning policy",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *PruningPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -103,7 +142,6 @@ func (r *PruningPolicyResource) Configure(ctx context.Context, req resource.Conf
 	}
 
 	client, ok := req.ProviderData.(client.Client)
-	client.HTTPClient.Timeout = 240 * time.Second
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Client error",
@@ -122,6 +160,13 @@ func (r *PruningPolicyResource) Create(ctx context.Context, req resource.CreateR
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	pruningPolicy := client.CreatePruningPolicy{
 		Enabled: true,
 		Rules:   client.PruningPolicyRulesToAPI(ctx, data.Rules),
@@ -171,6 +216,7 @@ func (r *PruningPolicyResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *PruningPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -184,6 +230,13 @@ func (r *PruningPolicyResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr pruning policy resource handler is in testing mode, no read will be run.")
 		data.Id = types.StringValue(TestingVersion)
@@ -193,13 +246,11 @@ func (r *PruningPolicyResource) Read(ctx context.Context, req resource.ReadReque
 			resp.Diagnostics.AddError("Client Error", err.Error())
 			return
 		}
-		data.Id = types.StringValue(rPolicy.ID)
-		data.Enabled = basetypes.NewBoolValue(rPolicy.Enabled)
-		data.Rules = client.PruningPolicyRulesToTFSDK(ctx, rPolicy.Rules)
-
+		data.fromPruningPolicy(ctx, rPolicy)
 	}
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *PruningPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -214,6 +265,13 @@ func (r *PruningPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr pruning policy resource handler is in testing mode, no update will be run.")
 		data.Id = types.StringValue(TestingVersion)
@@ -236,6 +294,7 @@ func (r *PruningPolicyResource) Update(ctx context.Context, req resource.UpdateR
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	// Set refreshed state
 	tflog.Debug(ctx, fmt.Sprintf("Updated Pruning Policy with ID %s of for %s/%s ", data.Id, data.OrgName, data.RepoName), map[string]any{"success": true})
 }
@@ -247,6 +306,13 @@ func (r *PruningPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr pruning policy resource handler is in testing mode, no deletion will be run.")
 	} else if err := r.client.DeletePruningPolicy(ctx, data.OrgName.ValueString(), data.RepoName.ValueString(), data.Id.ValueString()); err != nil {
@@ -258,19 +324,42 @@ func (r *PruningPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *PruningPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: org_name,repo_name,pruning_policy_id. Got: %q", req.ID),
-		)
+	var orgName, repoName, policyID string
+	if req.ID == "" {
+		var identity PruningPolicyResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		orgName, repoName, policyID = identity.OrgName.ValueString(), identity.RepoName.ValueString(), identity.PolicyID.ValueString()
+	} else {
+		var rest []string
+		var diags diag.Diagnostics
+		orgName, repoName, rest, diags = importRepo(ctx, r.client, req.ID, 1,
+			"org_name/repo_name/pruning_policy_id or repo_id/pruning_policy_id")
+		resp.Diagnostics.Append(diags...)
+		if len(rest) > 0 {
+			policyID = rest[0]
+		}
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), idParts[1])...)
-	// policy ID
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), orgName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), repoName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policyID)...)
+}
+
+// fromPruningPolicy sets the MSR managed values of the model from a pruning policy.
+func (m *PruningPolicyResourceModel) fromPruningPolicy(ctx context.Context, policy client.ResponsePruningPolicy) {
+	m.Id = types.StringValue(policy.ID)
+	m.Enabled = types.BoolValue(policy.Enabled)
+	m.Rules = client.PruningPolicyRulesToTFSDK(ctx, policy.Rules)
+}
+
+// identity returns the identity of the pruning policy.
+func (m *PruningPolicyResourceModel) identity() PruningPolicyResourceIdentityModel {
+	return PruningPolicyResourceIdentityModel{
+		OrgName:  m.OrgName,
+		RepoName: m.RepoName,
+		PolicyID: m.Id,
+	}
 }
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestPruningPolicyResourceDefault(t *testing.T) {
@@ -34,7 +36,19 @@ func TestPruningPolicyResourceDefault(t *testing.T) {
 				ImportState:   true,
 				ImportStateId: "test,test,test",
 			},
-			// Update and Read testing
+			// ImportState testing with a slash-separated identifier
+			{
+				ResourceName:  "msr_pruning_policy.test",
+				ImportState:   true,
+				ImportStateId: "test/test/test",
+			},
+			// ImportState testing with the MSR ID of the repo
+			{
+				ResourceName:  "msr_pruning_policy.test",
+				ImportState:   true,
+				ImportStateId: "r-1/test",
+			},
+			// Replace and Read testing
 			{
 				Config: providerConfig + `
 					resource "msr_pruning_policy" "test" {
@@ -47,6 +61,11 @@ func TestPruningPolicyResourceDefault(t *testing.T) {
 							values = ["blah"]
 						}
 				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_pruning_policy.test", plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("msr_pruning_policy.test", "enabled", "false"),
 					resource.TestCheckResourceAttr("msr_pruning_policy.test", "org_name", "blah"),
@@ -62,6 +81,44 @@ func TestPruningPolicyResourceDefault(t *testing.T) {
 	})
 }
 
+func TestPruningPolicyResourceTimeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The duration must be parsable
+			{
+				Config:      providerConfig + testPruningPolicyResourceTimeouts("ten minutes"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Time Duration"),
+			},
+			{
+				Config: providerConfig + testPruningPolicyResourceTimeouts("10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_pruning_policy.test", "timeouts.create", "10m"),
+					resource.TestCheckNoResourceAttr("msr_pruning_policy.test", "timeouts.delete"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testPruningPolicyResourceTimeouts(create string) string {
+	return `
+	resource "msr_pruning_policy" "test" {
+		org_name = "test"
+		repo_name = "test"
+		rule {
+			field = "tag"
+			operator = "matches"
+			values = ["^dev-"]
+		}
+
+		timeouts {
+			create = "` + create + `"
+		}
+	}`
+}
+
 func testPruningPolicyResourceDefault() string {
 	return `
 	resource "msr_pruning_policy" "test" {
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &repoCollaboratorsDataSource{}
+)
+
+func NewRepoCollaboratorsDataSource() datasource.DataSource {
+	return &repoCollaboratorsDataSource{}
+}
+
+type repoCollaboratorsDataSource struct {
+	client client.Client
+}
+
+// repoCollaboratorsDataSourceModel maps the data source schema data.
+type repoCollaboratorsDataSourceModel struct {
+	ID            types.String            `tfsdk:"id"`
+	Namespace     types.String            `tfsdk:"namespace"`
+	RepoName      types.String            `tfsdk:"repo_name"`
+	Collaborators []collaboratorDataModel `tfsdk:"collaborators"`
+}
+
+// collaboratorDataModel maps a single user granted access to the repo.
+type collaboratorDataModel struct {
+	Username    types.String `tfsdk:"username"`
+	UserID      types.String `tfsdk:"user_id"`
+	AccessLevel types.String `tfsdk:"access_level"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repoCollaboratorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *repoCollaboratorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_collaborators"
+}
+
+func (d *repoCollaboratorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repo collaborators data source. Lists the users granted access to a repo in a user namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The user namespace that contains the repo",
+				Required:            true,
+			},
+			"repo_name": schema.StringAttribute{
+				MarkdownDescription: "The repository to retrieve the collaborators of",
+				Required:            true,
+			},
+			"collaborators": schema.ListNestedAttribute{
+				MarkdownDescription: "The users granted access to the repo",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The name of the user",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user",
+							Computed:            true,
+						},
+						"access_level": schema.StringAttribute{
+							MarkdownDescription: "The access level granted to the user",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *repoCollaboratorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read repo collaborators data source")
+	var data repoCollaboratorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.Namespace.ValueString(),
+		data.RepoName.ValueString(),
+	))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo collaborators datasource handler is in testing mode, no injestion will be run.")
+		data.Collaborators = []collaboratorDataModel{}
+	} else {
+		rAccesses, err := d.client.ReadRepoUserAccesses(ctx, data.Namespace.ValueString(), data.RepoName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Repo Collaborators",
+				err.Error(),
+			)
+			return
+		}
+
+		collaborators := []collaboratorDataModel{}
+		for _, a := range rAccesses {
+			collaborators = append(collaborators, collaboratorDataModel{
+				Username:    basetypes.NewStringValue(a.User.Name),
+				UserID:      basetypes.NewStringValue(a.User.ID),
+				AccessLevel: basetypes.NewStringValue(a.AccessLevel),
+			})
+		}
+		data.Collaborators = collaborators
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d collaborators in repo collaborators data source", len(collaborators)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading repo collaborators data source", map[string]any{"success": true})
+}
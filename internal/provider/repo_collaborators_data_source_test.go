@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepoCollaboratorsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_repo_collaborators" "test" {
+					namespace = "someone"
+					repo_name = "test"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_repo_collaborators.test", "collaborators.#", "0"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.msr_repo_collaborators.test", "id"),
+				),
+			},
+		},
+	})
+}
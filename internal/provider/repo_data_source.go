@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &repoDataSource{}
+)
+
+func NewRepoDataSource() datasource.DataSource {
+	return &repoDataSource{}
+}
+
+type repoDataSource struct {
+	client client.Client
+}
+
+// repoDataSourceModel maps the data source schema data.
+type repoDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Namespace        types.String `tfsdk:"namespace"`
+	Name             types.String `tfsdk:"name"`
+	NamespaceType    types.String `tfsdk:"namespace_type"`
+	Visibility       types.String `tfsdk:"visibility"`
+	ScanOnPush       types.Bool   `tfsdk:"scan_on_push"`
+	ImmutableTags    types.Bool   `tfsdk:"immutable_tags"`
+	ShortDescription types.String `tfsdk:"short_description"`
+	LongDescription  types.String `tfsdk:"long_description"`
+	TagLimit         types.Int64  `tfsdk:"tag_limit"`
+	Pulls            types.Int64  `tfsdk:"pulls"`
+	Pushes           types.Int64  `tfsdk:"pushes"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *repoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo"
+}
+
+func (d *repoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repo data source. Looks up a repository of a namespace by name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the repository",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The organization or user namespace of the repository",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository",
+				Required:            true,
+			},
+			"namespace_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the namespace, `organization` or `user`",
+				Computed:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "The visibility of the repository, `public` or `private`",
+				Computed:            true,
+			},
+			"scan_on_push": schema.BoolAttribute{
+				MarkdownDescription: "Are the images scanned on push",
+				Computed:            true,
+			},
+			"immutable_tags": schema.BoolAttribute{
+				MarkdownDescription: "Are the tags immutable",
+				Computed:            true,
+			},
+			"short_description": schema.StringAttribute{
+				MarkdownDescription: "The short description of the repository",
+				Computed:            true,
+			},
+			"long_description": schema.StringAttribute{
+				MarkdownDescription: "The long description of the repository",
+				Computed:            true,
+			},
+			"tag_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of tags of the repository, 0 being unlimited",
+				Computed:            true,
+			},
+			"pulls": schema.Int64Attribute{
+				MarkdownDescription: "The number of pulls from the repository",
+				Computed:            true,
+			},
+			"pushes": schema.Int64Attribute{
+				MarkdownDescription: "The number of pushes to the repository",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *repoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read repo data source")
+	var data repoDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo datasource handler is in testing mode, no injestion will be run.")
+		data.fromRepo(client.ResponseRepo{
+			ID:         TestingVersion,
+			Namespace:  data.Namespace.ValueString(),
+			Name:       data.Name.ValueString(),
+			Visibility: "private",
+		})
+	} else {
+		rRepo, err := d.client.ReadRepo(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Repo",
+				err.Error(),
+			)
+			return
+		}
+		data.fromRepo(rRepo)
+
+		tflog.Trace(ctx, fmt.Sprintf("read in repo data source `%s`", rRepo.ID))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading repo data source", map[string]any{"success": true})
+}
+
+// fromRepo fills the model from the MSR repository.
+func (m *repoDataSourceModel) fromRepo(repo client.ResponseRepo) {
+	m.ID = types.StringValue(repo.ID)
+	m.Namespace = types.StringValue(repo.Namespace)
+	m.Name = types.StringValue(repo.Name)
+	m.NamespaceType = types.StringValue(repo.NamespaceType)
+	m.Visibility = types.StringValue(repo.Visibility)
+	m.ScanOnPush = types.BoolValue(repo.ScanOnPush)
+	m.ImmutableTags = types.BoolValue(repo.ImmutableTags)
+	m.ShortDescription = types.StringValue(repo.ShortDescription)
+	m.LongDescription = types.StringValue(repo.LongDescription)
+	m.TagLimit = types.Int64Value(int64(repo.TagLimit))
+	m.Pulls = types.Int64Value(int64(repo.Pulls))
+	m.Pushes = types.Int64Value(int64(repo.Pushes))
+}
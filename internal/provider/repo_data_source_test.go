@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_repo" "test" {
+					namespace = "example"
+					name      = "app"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_repo.test", "namespace", "example"),
+					resource.TestCheckResourceAttr("data.msr_repo.test", "visibility", "private"),
+					resource.TestCheckResourceAttr("data.msr_repo.test", "tag_limit", "0"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.msr_repo.test", "id", TestingVersion),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &RepoListResource{}
+	_ list.ListResourceWithConfigure = &RepoListResource{}
+)
+
+type RepoListResourceModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// RepoListResource lists the repos of MSR for `terraform query`.
+type RepoListResource struct {
+	client client.Client
+}
+
+func NewRepoListResource() list.ListResource {
+	return &RepoListResource{}
+}
+
+func (r *RepoListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo"
+}
+
+func (r *RepoListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the repos, to import them",
+
+		Attributes: map[string]listschema.Attribute{
+			"namespace": listschema.StringAttribute{
+				MarkdownDescription: "Only list the repos of the organization or user. All the repos are listed when unset",
+				Optional:            true,
+			},
+			"name_regex": listschema.StringAttribute{
+				MarkdownDescription: "Only list the repos whose name matches the regular expression",
+				Optional:            true,
+				Validators:          []validator.String{regexValidator{}},
+			},
+		},
+	}
+}
+
+func (r *RepoListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepoListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data RepoListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var repos []client.ResponseRepo
+	if r.client.TestMode {
+		diags.AddWarning("testing mode warning", "msr repo list resource handler is in testing mode, no listing will be run.")
+		repos = []client.ResponseRepo{{ID: TestingVersion, Name: TestingVersion, Namespace: TestingVersion, Visibility: "private"}}
+	} else {
+		var err error
+		repos, err = r.client.ReadRepos(ctx, data.Namespace.ValueString())
+		if err != nil {
+			diags.AddError("Unable to List Repos", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	nameRegex := listNameRegex(data.NameRegex)
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for _, repo := range repos {
+			if listLimitReached(req, count) {
+				return
+			}
+			if nameRegex != nil && !nameRegex.MatchString(repo.Name) {
+				continue
+			}
+
+			model := RepoResourceModel{
+				OrgName:                  types.StringValue(repo.Namespace),
+				PreventDestroyIfNotEmpty: types.BoolValue(false),
+				DeletionProtection:       types.BoolValue(false),
+				OnDestroy:                types.StringValue(onDestroyDelete),
+				Timeouts:                 nullTimeouts(),
+			}
+			model.fromRepo(repo)
+
+			result := newListResult(ctx, req, repo.Namespace+"/"+repo.Name, model.identity(), model)
+			result.Diagnostics.Append(diags...)
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRepoListResource(t *testing.T) {
+	ctx := context.Background()
+	server := newTestMSRServer(t)
+
+	results := testListResults(t, server, NewRepoListResource(), NewRepoResource(), map[string]string{"namespace": "acme"}, true, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 repos, got %d", len(results))
+	}
+	if results[0].DisplayName != "acme/app" {
+		t.Errorf("expected the acme/app display name, got %q", results[0].DisplayName)
+	}
+
+	var identity RepoResourceIdentityModel
+	if diags := results[1].Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("reading the identity: %v", diags)
+	}
+	if identity.OrgName.ValueString() != "acme" || identity.RepoName.ValueString() != "lib" {
+		t.Errorf("expected the acme/lib identity, got %s/%s", identity.OrgName, identity.RepoName)
+	}
+
+	var model RepoResourceModel
+	if diags := results[1].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading the resource: %v", diags)
+	}
+	if model.Id.ValueString() != "r-2" || model.Visibility.ValueString() != "public" || !model.ScanOnPush.ValueBool() {
+		t.Errorf("unexpected resource %+v", model)
+	}
+	if model.OnDestroy.ValueString() != onDestroyDelete || model.DeletionProtection.ValueBool() {
+		t.Errorf("expected the destroy defaults, got %+v", model)
+	}
+
+	// The name filter and the limit narrow the results down
+	results = testListResults(t, server, NewRepoListResource(), NewRepoResource(), map[string]string{"name_regex": "^l"}, false, 0)
+	if len(results) != 1 || results[0].DisplayName != "acme/lib" {
+		t.Errorf("expected only acme/lib, got %d results", len(results))
+	}
+	results = testListResults(t, server, NewRepoListResource(), NewRepoResource(), nil, false, 1)
+	if len(results) != 1 {
+		t.Errorf("expected the limit of 1 result, got %d", len(results))
+	}
+}
@@ -3,11 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,14 +20,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &RepoResource{}
+var (
+	_ resource.Resource                 = &RepoResource{}
+	_ resource.ResourceWithModifyPlan   = &RepoResource{}
+	_ resource.ResourceWithIdentity     = &RepoResource{}
+	_ resource.ResourceWithUpgradeState = &RepoResource{}
+)
 
 type RepoResourceModel struct {
-	Name       types.String `tfsdk:"name"`
-	OrgName    types.String `tfsdk:"org_name"`
-	ScanOnPush types.Bool   `tfsdk:"scan_on_push"`
-	Visibility types.String `tfsdk:"visibility"`
-	Id         types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	OrgName       types.String `tfsdk:"org_name"`
+	ScanOnPush    types.Bool   `tfsdk:"scan_on_push"`
+	Visibility    types.String `tfsdk:"visibility"`
+	ImmutableTags types.Bool   `tfsdk:"immutable_tags"`
+	// PreventDestroyIfNotEmpty is only used by the provider, it isn't sent to MSR
+	PreventDestroyIfNotEmpty types.Bool     `tfsdk:"prevent_destroy_if_not_empty"`
+	DeletionProtection       types.Bool     `tfsdk:"deletion_protection"`
+	OnDestroy                types.String   `tfsdk:"on_destroy"`
+	Id                       types.String   `tfsdk:"id"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+// RepoResourceIdentityModel identifies a repo by its namespace and name.
+type RepoResourceIdentityModel struct {
+	OrgName  types.String `tfsdk:"org_name"`
+	RepoName types.String `tfsdk:"repo_name"`
 }
 
 type RepoResource struct {
@@ -43,8 +62,13 @@ func (r *RepoResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *RepoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -54,12 +78,18 @@ func (r *RepoResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the team",
+				MarkdownDescription: "The name of the repo. MSR can't rename repos, changing it replaces the repo and destroys its tags",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"org_name": schema.StringAttribute{
-				MarkdownDescription: "The organization name for the repo",
+				MarkdownDescription: "The organization name for the repo. MSR can't move repos, changing it replaces the repo and destroys its tags",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"visibility": schema.StringAttribute{
 				MarkdownDescription: "The visibility of the the repo",
@@ -73,11 +103,109 @@ func (r *RepoResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"immutable_tags": schema.BoolAttribute{
+				MarkdownDescription: "Prevent tags from being overwritten, so that signed tags keep pointing to the signed image",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"prevent_destroy_if_not_empty": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to delete the repo, including when replacing it, while it still has tags",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_protection": deletionProtectionAttribute(),
+			"on_destroy":          onDestroyAttribute(),
 		},
 		MarkdownDescription: "Repo resource",
 	}
 }
 
+// repoResourceModelV0 is the repo state before immutable_tags and the provider side attributes.
+type repoResourceModelV0 struct {
+	Name       types.String `tfsdk:"name"`
+	OrgName    types.String `tfsdk:"org_name"`
+	ScanOnPush types.Bool   `tfsdk:"scan_on_push"`
+	Visibility types.String `tfsdk:"visibility"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func (r *RepoResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 adds immutable_tags, prevent_destroy_if_not_empty, deletion_protection and on_destroy
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":           schema.StringAttribute{Computed: true},
+					"name":         schema.StringAttribute{Required: true},
+					"org_name":     schema.StringAttribute{Required: true},
+					"visibility":   schema.StringAttribute{Optional: true, Computed: true},
+					"scan_on_push": schema.BoolAttribute{Optional: true, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior repoResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The repos were created with mutable tags, the next read refreshes it
+				resp.Diagnostics.Append(resp.State.Set(ctx, RepoResourceModel{
+					Name:                     prior.Name,
+					OrgName:                  prior.OrgName,
+					ScanOnPush:               prior.ScanOnPush,
+					Visibility:               prior.Visibility,
+					ImmutableTags:            types.BoolValue(false),
+					PreventDestroyIfNotEmpty: types.BoolValue(false),
+					DeletionProtection:       types.BoolValue(false),
+					OnDestroy:                types.StringValue(onDestroyDelete),
+					Timeouts:                 nullTimeouts(),
+					Id:                       prior.Id,
+				})...)
+			},
+		},
+	}
+}
+
+func (r *RepoResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_name": identityschema.StringAttribute{
+				Description:       "The organization name for the repo",
+				RequiredForImport: true,
+			},
+			"repo_name": identityschema.StringAttribute{
+				Description:       "The name of the repo",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RepoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to warn about on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *RepoResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Name.Equal(plan.Name) || !state.OrgName.Equal(plan.OrgName) {
+		resp.Diagnostics.AddWarning(
+			"Repo replacement",
+			fmt.Sprintf("MSR can't rename or move repos. Repo %s/%s will be deleted along with all its tags, and %s/%s created empty.",
+				state.OrgName.ValueString(), state.Name.ValueString(), plan.OrgName.ValueString(), plan.Name.ValueString()),
+		)
+	}
+}
+
 func (r *RepoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -103,10 +231,18 @@ func (r *RepoResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	repo := client.CreateRepo{
-		Name:       data.Name.ValueString(),
-		ScanOnPush: data.ScanOnPush.ValueBool(),
-		Visibility: data.Visibility.ValueString(),
+		Name:          data.Name.ValueString(),
+		ScanOnPush:    data.ScanOnPush.ValueBool(),
+		Visibility:    data.Visibility.ValueString(),
+		ImmutableTags: data.ImmutableTags.ValueBool(),
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -131,6 +267,7 @@ func (r *RepoResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *RepoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,6 +281,13 @@ func (r *RepoResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr repo resource handler is in testing mode, no read will be run.")
 		data.Id = types.StringValue(TestingVersion)
@@ -156,14 +300,12 @@ func (r *RepoResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			)
 			return
 		}
-		data.Id = types.StringValue(r.ID)
-		data.Name = types.StringValue(r.Name)
-		data.ScanOnPush = types.BoolValue(r.ScanOnPush)
-		data.Visibility = types.StringValue(r.Visibility)
+		data.fromRepo(r)
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *RepoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -178,13 +320,21 @@ func (r *RepoResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr repo resource handler is in testing mode, no update will be run.")
 		data.Id = types.StringValue(TestingVersion)
 	} else {
 		repo := client.UpdateRepo{
-			ScanOnPush: data.ScanOnPush.ValueBool(),
-			Visibility: data.Visibility.ValueString(),
+			ScanOnPush:    data.ScanOnPush.ValueBool(),
+			Visibility:    data.Visibility.ValueString(),
+			ImmutableTags: data.ImmutableTags.ValueBool(),
 		}
 		rRepo, err := r.client.UpdateRepo(ctx, data.OrgName.ValueString(), data.Name.ValueString(), repo)
 		if err != nil {
@@ -193,11 +343,13 @@ func (r *RepoResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 
 		data.ScanOnPush = types.BoolValue(rRepo.ScanOnPush)
+		data.ImmutableTags = types.BoolValue(rRepo.ImmutableTags)
 		data.Id = types.StringValue(rRepo.ID)
 	}
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	tflog.Debug(ctx, "Updated 'repo' resource", map[string]any{"success": true})
 }
 
@@ -207,9 +359,45 @@ func (r *RepoResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRepo, diags := checkDestroy(ctx, "repo", data.OrgName.ValueString()+"/"+data.Name.ValueString(), data.DeletionProtection, data.OnDestroy)
+	resp.Diagnostics.Append(diags...)
+	if !deleteRepo {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr user resource handler is in testing mode, no deletion will be run.")
-	} else if err := r.client.DeleteRepo(ctx, data.OrgName.ValueString(), data.Name.ValueString()); err != nil {
+		return
+	}
+
+	if data.PreventDestroyIfNotEmpty.ValueBool() {
+		tags, err := r.client.ReadRepoTags(ctx, data.OrgName.ValueString(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		if len(tags) > 0 {
+			resp.Diagnostics.AddError(
+				"Repo is not empty",
+				fmt.Sprintf("Repo %s/%s still has %d tags and prevent_destroy_if_not_empty is set. Delete its tags or unset prevent_destroy_if_not_empty first.",
+					data.OrgName.ValueString(), data.Name.ValueString(), len(tags)),
+			)
+			return
+		}
+	}
+
+	if err := r.client.DeleteRepo(ctx, data.OrgName.ValueString(), data.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
@@ -218,17 +406,39 @@ func (r *RepoResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *RepoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: org_name,repo_name. Got: %q", req.ID),
-		)
+	var orgName, repoName string
+	if req.ID == "" {
+		var identity RepoResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		orgName, repoName = identity.OrgName.ValueString(), identity.RepoName.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		orgName, repoName, _, diags = importRepo(ctx, r.client, req.ID, 0, "org_name/repo_name or repo_id")
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), orgName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), repoName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prevent_destroy_if_not_empty"), false)...)
+	resp.Diagnostics.Append(importDestroyDefaults(ctx, &resp.State)...)
+}
+
+// fromRepo sets the MSR managed values of the model from a repo.
+func (m *RepoResourceModel) fromRepo(repo client.ResponseRepo) {
+	m.Id = types.StringValue(repo.ID)
+	m.Name = types.StringValue(repo.Name)
+	m.ScanOnPush = types.BoolValue(repo.ScanOnPush)
+	m.Visibility = types.StringValue(repo.Visibility)
+	m.ImmutableTags = types.BoolValue(repo.ImmutableTags)
+}
+
+// identity returns the identity of the repo.
+func (m *RepoResourceModel) identity() RepoResourceIdentityModel {
+	return RepoResourceIdentityModel{
+		OrgName:  m.OrgName,
+		RepoName: m.Name,
+	}
 }
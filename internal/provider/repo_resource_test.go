@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestRepoResourceDefault(t *testing.T) {
@@ -19,6 +21,8 @@ func TestRepoResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("msr_repo.test", "org_name", TestingVersion),
 					resource.TestCheckResourceAttr("msr_repo.test", "visibility", "private"),
 					resource.TestCheckResourceAttr("msr_repo.test", "scan_on_push", "false"),
+					resource.TestCheckResourceAttr("msr_repo.test", "immutable_tags", "false"),
+					resource.TestCheckResourceAttr("msr_repo.test", "prevent_destroy_if_not_empty", "false"),
 					resource.TestCheckResourceAttrSet("msr_repo.test", "id"),
 				),
 			},
@@ -30,6 +34,7 @@ func TestRepoResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("msr_repo.test", "org_name", TestingVersion),
 					resource.TestCheckResourceAttr("msr_repo.test", "visibility", "public"),
 					resource.TestCheckResourceAttr("msr_repo.test", "scan_on_push", "true"),
+					resource.TestCheckResourceAttr("msr_repo.test", "immutable_tags", "true"),
 					resource.TestCheckResourceAttrSet("msr_repo.test", "id"),
 				),
 			},
@@ -39,7 +44,19 @@ func TestRepoResourceDefault(t *testing.T) {
 				ImportStateId: "test,test",
 				ImportState:   true,
 			},
-			// Update and Read testing
+			// ImportState testing with a slash-separated identifier
+			{
+				ResourceName:  "msr_repo.test",
+				ImportStateId: "test/test",
+				ImportState:   true,
+			},
+			// ImportState testing with the MSR ID of the repo
+			{
+				ResourceName:  "msr_repo.test",
+				ImportStateId: "r-1",
+				ImportState:   true,
+			},
+			// Replace and Read testing
 			{
 				Config: providerConfig + `
 				resource "msr_repo" "test" {
@@ -47,12 +64,19 @@ func TestRepoResourceDefault(t *testing.T) {
 				org_name = "blah"
 				visibility = "blah"
 				scan_on_push = "false"
+				prevent_destroy_if_not_empty = true
 			}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_repo.test", plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("msr_repo.test", "name", "blah"),
 					resource.TestCheckResourceAttr("msr_repo.test", "org_name", "blah"),
 					resource.TestCheckResourceAttr("msr_repo.test", "visibility", "blah"),
 					resource.TestCheckResourceAttr("msr_repo.test", "scan_on_push", "false"),
+					resource.TestCheckResourceAttr("msr_repo.test", "prevent_destroy_if_not_empty", "true"),
 				),
 			},
 			// Delete is called implicitly
@@ -60,6 +84,39 @@ func TestRepoResourceDefault(t *testing.T) {
 	})
 }
 
+func TestRepoResourceAbandon(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time on_destroy validation
+			{
+				Config: providerConfig + `
+				resource "msr_repo" "test" {
+					name       = "test"
+					org_name   = "test"
+					on_destroy = "keep"
+				}`,
+				ExpectError: regexp.MustCompile("on_destroy"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "msr_repo" "test" {
+					name       = "test"
+					org_name   = "test"
+					on_destroy = "abandon"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_repo.test", "on_destroy", "abandon"),
+					resource.TestCheckResourceAttr("msr_repo.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete only removes the repo from the state
+		},
+	})
+}
+
 func testRepoResourceDefault() string {
 	return `
 	resource "msr_repo" "test" {
@@ -75,5 +132,6 @@ func testRepoResourceValuesSet() string {
 		org_name = "test"
 		visibility = "public"
 		scan_on_push = "true"
+		immutable_tags = "true"
 	}`
 }
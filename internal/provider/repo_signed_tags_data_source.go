@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &repoSignedTagsDataSource{}
+)
+
+func NewRepoSignedTagsDataSource() datasource.DataSource {
+	return &repoSignedTagsDataSource{}
+}
+
+type repoSignedTagsDataSource struct {
+	client client.Client
+}
+
+// repoSignedTagsDataSourceModel maps the data source schema data.
+type repoSignedTagsDataSourceModel struct {
+	ID         types.String         `tfsdk:"id"`
+	OrgName    types.String         `tfsdk:"org_name"`
+	RepoName   types.String         `tfsdk:"repo_name"`
+	SignedOnly types.Bool           `tfsdk:"signed_only"`
+	Tags       []signedTagDataModel `tfsdk:"tags"`
+}
+
+// signedTagDataModel maps a single tag and its trust data.
+type signedTagDataModel struct {
+	Name         types.String   `tfsdk:"name"`
+	Digest       types.String   `tfsdk:"digest"`
+	Signed       types.Bool     `tfsdk:"signed"`
+	HashMismatch types.Bool     `tfsdk:"hash_mismatch"`
+	Signers      []types.String `tfsdk:"signers"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repoSignedTagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *repoSignedTagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_signed_tags"
+}
+
+func (d *repoSignedTagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repo signed tags data source. Reports which tags of a repo are signed and by which delegations",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "The organization that contains the repo",
+				Required:            true,
+			},
+			"repo_name": schema.StringAttribute{
+				MarkdownDescription: "The repository to retrieve the tags of",
+				Required:            true,
+			},
+			"signed_only": schema.BoolAttribute{
+				MarkdownDescription: "Only retrieve the signed tags",
+				Optional:            true,
+			},
+			"tags": schema.ListNestedAttribute{
+				MarkdownDescription: "The tags of the repo",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the tag",
+							Computed:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "The digest of the image the tag points to",
+							Computed:            true,
+						},
+						"signed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tag is signed and its signature matches the image",
+							Computed:            true,
+						},
+						"hash_mismatch": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tag was pushed again after being signed",
+							Computed:            true,
+						},
+						"signers": schema.ListAttribute{
+							MarkdownDescription: "The delegations which signed the tag, e.g. `targets/releases`",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *repoSignedTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read repo signed tags data source")
+	var data repoSignedTagsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.OrgName.ValueString(),
+		data.RepoName.ValueString(),
+		fmt.Sprint(data.SignedOnly.ValueBool()),
+	))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo signed tags datasource handler is in testing mode, no injestion will be run.")
+		data.Tags = []signedTagDataModel{}
+	} else {
+		rTags, err := d.client.ReadRepoTags(ctx, data.OrgName.ValueString(), data.RepoName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Repo Tags",
+				err.Error(),
+			)
+			return
+		}
+
+		tags := []signedTagDataModel{}
+		for _, t := range rTags {
+			if data.SignedOnly.ValueBool() && !t.IsSigned() {
+				continue
+			}
+			signers := []types.String{}
+			for _, s := range t.Signers {
+				signers = append(signers, basetypes.NewStringValue(s))
+			}
+			tags = append(tags, signedTagDataModel{
+				Name:         basetypes.NewStringValue(t.Name),
+				Digest:       basetypes.NewStringValue(t.Digest),
+				Signed:       basetypes.NewBoolValue(t.IsSigned()),
+				HashMismatch: basetypes.NewBoolValue(t.HashMismatch),
+				Signers:      signers,
+			})
+		}
+		data.Tags = tags
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d tags in repo signed tags data source", len(tags)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading repo signed tags data source", map[string]any{"success": true})
+}
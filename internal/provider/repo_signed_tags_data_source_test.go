@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepoSignedTagsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_repo_signed_tags" "test" {
+					org_name    = "test"
+					repo_name   = "test"
+					signed_only = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_repo_signed_tags.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("data.msr_repo_signed_tags.test", "signed_only", "true"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.msr_repo_signed_tags.test", "id"),
+				),
+			},
+		},
+	})
+}
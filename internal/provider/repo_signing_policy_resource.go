@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &RepoSigningPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &RepoSigningPolicyResource{}
+	_ resource.ResourceWithIdentity         = &RepoSigningPolicyResource{}
+)
+
+type RepoSigningPolicyResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	OrgName    types.String   `tfsdk:"org_name"`
+	RepoName   types.String   `tfsdk:"repo_name"`
+	Enforced   types.Bool     `tfsdk:"enforced"`
+	Promotion  *signersModel  `tfsdk:"promotion"`
+	Deployment *signersModel  `tfsdk:"deployment"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// RepoSigningPolicyResourceIdentityModel identifies a signing policy by the namespace and name of its repo.
+type RepoSigningPolicyResourceIdentityModel struct {
+	OrgName  types.String `tfsdk:"org_name"`
+	RepoName types.String `tfsdk:"repo_name"`
+}
+
+type signersModel struct {
+	Teams []types.String `tfsdk:"teams"`
+	Users []types.String `tfsdk:"users"`
+}
+
+type RepoSigningPolicyResource struct {
+	client client.Client
+}
+
+func NewRepoSigningPolicyResource() resource.Resource {
+	return &RepoSigningPolicyResource{}
+}
+
+func (r *RepoSigningPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_signing_policy"
+}
+
+// signersBlock is the schema of the signers required for a stage.
+func signersBlock(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.SetAttribute{
+				MarkdownDescription: "The teams, in the `org_name/team_name` format, a member of which must sign the tag",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[^/]+/[^/]+$`),
+						"must be in the org_name/team_name format",
+					)),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "The users who must sign the tag",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *RepoSigningPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repo signing policy resource. Describes the signatures required for the tags of a repo to be promoted and deployed",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "The organization that contains the repo",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				MarkdownDescription: "The repository to apply the signing policy on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enforced": schema.BoolAttribute{
+				MarkdownDescription: "Reject the tags missing the required signatures instead of only reporting them",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts":   timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+			"promotion":  signersBlock("The signers required for a tag to be promoted"),
+			"deployment": signersBlock("The signers required for a tag to be deployed"),
+		},
+	}
+}
+
+func (r *RepoSigningPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_name": identityschema.StringAttribute{
+				Description:       "The organization name for the repo",
+				RequiredForImport: true,
+			},
+			"repo_name": identityschema.StringAttribute{
+				Description:       "The name of the repo",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RepoSigningPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("promotion"),
+			path.MatchRoot("deployment"),
+		),
+	}
+}
+
+func (r *RepoSigningPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepoSigningPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RepoSigningPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo signing policy resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rPolicy, err := r.client.UpdateSigningPolicy(ctx, data.OrgName.ValueString(), data.RepoName.ValueString(), data.toSigningPolicy())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create signing policy error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("created signing policy for `%s/%s`", data.OrgName.ValueString(), data.RepoName.ValueString()))
+		data.Id = basetypes.NewStringValue(data.OrgName.ValueString() + "," + data.RepoName.ValueString())
+		data.Enforced = types.BoolValue(rPolicy.Enforced)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *RepoSigningPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read repo signing policy resource")
+	var data *RepoSigningPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo signing policy resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rPolicy, err := r.client.ReadSigningPolicy(ctx, data.OrgName.ValueString(), data.RepoName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.Id = types.StringValue(data.OrgName.ValueString() + "," + data.RepoName.ValueString())
+		data.fromSigningPolicy(rPolicy)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *RepoSigningPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update repo signing policy resource")
+
+	var data *RepoSigningPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo signing policy resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rPolicy, err := r.client.UpdateSigningPolicy(ctx, data.OrgName.ValueString(), data.RepoName.ValueString(), data.toSigningPolicy())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.Enforced = types.BoolValue(rPolicy.Enforced)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'repo signing policy' resource", map[string]any{"success": true})
+}
+
+func (r *RepoSigningPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RepoSigningPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo signing policy resource handler is in testing mode, no deletion will be run.")
+	} else if err := r.client.DeleteSigningPolicy(ctx, data.OrgName.ValueString(), data.RepoName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted repo signing policy resource", map[string]any{"success": true})
+}
+
+func (r *RepoSigningPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity RepoSigningPolicyResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), identity.OrgName)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), identity.RepoName)...)
+		return
+	}
+
+	orgName, repoName, _, diags := importRepo(ctx, r.client, req.ID, 0, "org_name/repo_name or repo_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_name"), orgName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), repoName)...)
+}
+
+// identity returns the identity of the signing policy.
+func (m *RepoSigningPolicyResourceModel) identity() RepoSigningPolicyResourceIdentityModel {
+	return RepoSigningPolicyResourceIdentityModel{
+		OrgName:  m.OrgName,
+		RepoName: m.RepoName,
+	}
+}
+
+// toSigningPolicy converts the model to the MSR signing policy.
+func (m *RepoSigningPolicyResourceModel) toSigningPolicy() client.SigningPolicy {
+	return client.SigningPolicy{
+		Enforced:   m.Enforced.ValueBool(),
+		Promotion:  m.Promotion.toSigners(),
+		Deployment: m.Deployment.toSigners(),
+	}
+}
+
+// fromSigningPolicy refreshes the model from the MSR signing policy.
+// A stage without signers is kept as an absent block, unless the prior block was set.
+func (m *RepoSigningPolicyResourceModel) fromSigningPolicy(policy client.SigningPolicy) {
+	m.Enforced = types.BoolValue(policy.Enforced)
+	m.Promotion = fromSigners(m.Promotion, policy.Promotion)
+	m.Deployment = fromSigners(m.Deployment, policy.Deployment)
+}
+
+func (s *signersModel) toSigners() client.Signers {
+	signers := client.Signers{Teams: []string{}, Users: []string{}}
+	if s == nil {
+		return signers
+	}
+	for _, t := range s.Teams {
+		signers.Teams = append(signers.Teams, t.ValueString())
+	}
+	for _, u := range s.Users {
+		signers.Users = append(signers.Users, u.ValueString())
+	}
+	return signers
+}
+
+// fromSigners converts the signers of a stage, keeping the empty lists and blocks of the prior model
+// so that a block configured without signers doesn't show as a change.
+func fromSigners(prior *signersModel, signers client.Signers) *signersModel {
+	if prior == nil && len(signers.Teams) == 0 && len(signers.Users) == 0 {
+		return nil
+	}
+	s := &signersModel{}
+	if prior != nil && prior.Teams != nil {
+		s.Teams = []types.String{}
+	}
+	if prior != nil && prior.Users != nil {
+		s.Users = []types.String{}
+	}
+	for _, t := range signers.Teams {
+		s.Teams = append(s.Teams, types.StringValue(t))
+	}
+	for _, u := range signers.Users {
+		s.Users = append(s.Users, types.StringValue(u))
+	}
+	return s
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepoSigningPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Teams must reference their organization
+			{
+				Config: providerConfig + `
+				resource "msr_repo_signing_policy" "test" {
+					org_name  = "test"
+					repo_name = "test"
+					promotion {
+						teams = ["release"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile("org_name/team_name"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "msr_repo_signing_policy" "test" {
+					org_name  = "test"
+					repo_name = "test"
+					promotion {
+						teams = ["test/release"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_repo_signing_policy.test", "enforced", "true"),
+					resource.TestCheckResourceAttr("msr_repo_signing_policy.test", "promotion.teams.#", "1"),
+					resource.TestCheckResourceAttr("msr_repo_signing_policy.test", "promotion.teams.0", "test/release"),
+					resource.TestCheckResourceAttr("msr_repo_signing_policy.test", "id", TestingVersion),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "msr_repo_signing_policy.test",
+				ImportStateId: "test/test",
+				ImportState:   true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "msr_repo_signing_policy" "test" {
+					org_name  = "test"
+					repo_name = "test"
+					enforced  = false
+					promotion {
+						teams = ["test/release"]
+					}
+					deployment {
+						users = ["ci"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_repo_signing_policy.test", "enforced", "false"),
+					resource.TestCheckResourceAttr("msr_repo_signing_policy.test", "deployment.users.0", "ci"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func TestFromSigners(t *testing.T) {
+	empty := client.Signers{Teams: []string{}, Users: []string{}}
+
+	// A stage never configured stays absent
+	if s := fromSigners(nil, empty); s != nil {
+		t.Errorf("expected no block, got %+v", s)
+	}
+
+	// A block configured without signers is kept as it is
+	prior := &signersModel{Teams: []types.String{}}
+	if s := fromSigners(prior, empty); !reflect.DeepEqual(s, &signersModel{Teams: []types.String{}}) {
+		t.Errorf("expected the empty block to be kept, got %+v", s)
+	}
+
+	// The signers read from MSR replace the prior ones
+	s := fromSigners(&signersModel{}, client.Signers{Users: []string{"ci"}})
+	if !reflect.DeepEqual(s, &signersModel{Users: []types.String{types.StringValue("ci")}}) {
+		t.Errorf("expected the ci user, got %+v", s)
+	}
+}
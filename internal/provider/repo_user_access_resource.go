@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// userNamespaceType is the namespace type MSR reports for repos owned by a user.
+const userNamespaceType = "user"
+
+var (
+	_ resource.Resource                = &RepoUserAccessResource{}
+	_ resource.ResourceWithImportState = &RepoUserAccessResource{}
+	_ resource.ResourceWithIdentity    = &RepoUserAccessResource{}
+)
+
+type RepoUserAccessResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Namespace   types.String   `tfsdk:"namespace"`
+	RepoName    types.String   `tfsdk:"repo_name"`
+	Username    types.String   `tfsdk:"username"`
+	AccessLevel types.String   `tfsdk:"access_level"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// RepoUserAccessResourceIdentityModel identifies the access of a user by the repo and the username.
+type RepoUserAccessResourceIdentityModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	RepoName  types.String `tfsdk:"repo_name"`
+	Username  types.String `tfsdk:"username"`
+}
+
+type RepoUserAccessResource struct {
+	client client.Client
+}
+
+func NewRepoUserAccessResource() resource.Resource {
+	return &RepoUserAccessResource{}
+}
+
+func (r *RepoUserAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_user_access"
+}
+
+func (r *RepoUserAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repo user access resource. Grants a user access to a repo in a user namespace",
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The user namespace that contains the repo",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				MarkdownDescription: "The repository to grant access to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The user granted access",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_level": schema.StringAttribute{
+				MarkdownDescription: "The access level granted, one of `read-only`, `read-write` or `admin`",
+				Required:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					client.RepoAccessReadOnly, client.RepoAccessReadWrite, client.RepoAccessAdmin,
+				)},
+			},
+		},
+	}
+}
+
+func (r *RepoUserAccessResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"namespace": identityschema.StringAttribute{
+				Description:       "The namespace of the repo",
+				RequiredForImport: true,
+			},
+			"repo_name": identityschema.StringAttribute{
+				Description:       "The name of the repo",
+				RequiredForImport: true,
+			},
+			"username": identityschema.StringAttribute{
+				Description:       "The name of the user",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RepoUserAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepoUserAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RepoUserAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo user access resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+	} else {
+		// Organization repos are shared through teams, user grants only apply to user namespaces
+		rRepo, err := r.client.ReadRepo(ctx, data.Namespace.ValueString(), data.RepoName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		if rRepo.NamespaceType != userNamespaceType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("namespace"),
+				"Unsupported namespace",
+				fmt.Sprintf("Repo %s/%s is in a %s namespace, user access can only be granted on repos in user namespaces. Use team access instead.",
+					data.Namespace.ValueString(), data.RepoName.ValueString(), rRepo.NamespaceType),
+			)
+			return
+		}
+
+		rAccess, err := r.client.UpdateRepoUserAccess(ctx, data.Namespace.ValueString(), data.RepoName.ValueString(), data.Username.ValueString(), data.AccessLevel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create repo user access error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("granted `%s` access to repo `%s/%s`", data.Username.ValueString(), data.Namespace.ValueString(), data.RepoName.ValueString()))
+		data.Id = basetypes.NewStringValue(strings.Join([]string{
+			data.Namespace.ValueString(), data.RepoName.ValueString(), data.Username.ValueString(),
+		}, ","))
+		data.AccessLevel = basetypes.NewStringValue(rAccess.AccessLevel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *RepoUserAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read repo user access resource")
+	var data *RepoUserAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo user access resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rAccess, err := r.client.ReadRepoUserAccess(ctx, data.Namespace.ValueString(), data.RepoName.ValueString(), data.Username.ValueString())
+		if errors.Is(err, client.ErrNotFound) {
+			// The access was revoked outside of Terraform, plan granting it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.Id = types.StringValue(strings.Join([]string{
+			data.Namespace.ValueString(), data.RepoName.ValueString(), data.Username.ValueString(),
+		}, ","))
+		data.AccessLevel = types.StringValue(rAccess.AccessLevel)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *RepoUserAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update repo user access resource")
+
+	var data *RepoUserAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo user access resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rAccess, err := r.client.UpdateRepoUserAccess(ctx, data.Namespace.ValueString(), data.RepoName.ValueString(), data.Username.ValueString(), data.AccessLevel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.AccessLevel = types.StringValue(rAccess.AccessLevel)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'repo user access' resource", map[string]any{"success": true})
+}
+
+func (r *RepoUserAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RepoUserAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repo user access resource handler is in testing mode, no deletion will be run.")
+	} else if err := r.client.DeleteRepoUserAccess(ctx, data.Namespace.ValueString(), data.RepoName.ValueString(), data.Username.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted repo user access resource", map[string]any{"success": true})
+}
+
+func (r *RepoUserAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity RepoUserAccessResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), identity.Namespace)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), identity.RepoName)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), identity.Username)...)
+		return
+	}
+
+	namespace, repoName, rest, diags := importRepo(ctx, r.client, req.ID, 1, "namespace/repo_name/username or repo_id/username")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), repoName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), rest[0])...)
+}
+
+// identity returns the identity of the user access.
+func (m *RepoUserAccessResourceModel) identity() RepoUserAccessResourceIdentityModel {
+	return RepoUserAccessResourceIdentityModel{
+		Namespace: m.Namespace,
+		RepoName:  m.RepoName,
+		Username:  m.Username,
+	}
+}
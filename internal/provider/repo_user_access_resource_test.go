@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepoUserAccessResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time access level validation
+			{
+				Config:      providerConfig + testRepoUserAccessResource("write"),
+				ExpectError: regexp.MustCompile("access_level"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testRepoUserAccessResource("read-only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_repo_user_access.test", "namespace", "someone"),
+					resource.TestCheckResourceAttr("msr_repo_user_access.test", "username", "ci"),
+					resource.TestCheckResourceAttr("msr_repo_user_access.test", "access_level", "read-only"),
+					resource.TestCheckResourceAttr("msr_repo_user_access.test", "id", TestingVersion),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "msr_repo_user_access.test",
+				ImportStateId: "someone/test/ci",
+				ImportState:   true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testRepoUserAccessResource("read-write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_repo_user_access.test", "access_level", "read-write"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testRepoUserAccessResource(accessLevel string) string {
+	return `
+	resource "msr_repo_user_access" "test" {
+		namespace    = "someone"
+		repo_name    = "test"
+		username     = "ci"
+		access_level = "` + accessLevel + `"
+	}`
+}
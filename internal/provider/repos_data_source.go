@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &reposDataSource{}
+)
+
+func NewReposDataSource() datasource.DataSource {
+	return &reposDataSource{}
+}
+
+type reposDataSource struct {
+	client client.Client
+}
+
+// reposDataSourceModel maps the data source schema data.
+type reposDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Namespace  types.String          `tfsdk:"namespace"`
+	Visibility types.String          `tfsdk:"visibility"`
+	NameRegex  types.String          `tfsdk:"name_regex"`
+	ScanOnPush types.Bool            `tfsdk:"scan_on_push"`
+	Repos      []repoDataSourceModel `tfsdk:"repos"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *reposDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *reposDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repos"
+}
+
+func (d *reposDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repos data source. Lists the repositories of MSR, or of a single namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Only list the repositories of the organization or user namespace",
+				Optional:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Only list the repositories with the visibility, `public` or `private`",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("public", "private")},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list the repositories whose name matches the regular expression",
+				Optional:            true,
+				Validators:          []validator.String{regexValidator{}},
+			},
+			"scan_on_push": schema.BoolAttribute{
+				MarkdownDescription: "Only list the repositories with this scan on push setting",
+				Optional:            true,
+			},
+			"repos": schema.ListNestedAttribute{
+				MarkdownDescription: "The repositories retrieved from MSR",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the repository",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "The organization or user namespace of the repository",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the repository",
+							Computed:            true,
+						},
+						"namespace_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the namespace, `organization` or `user`",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							MarkdownDescription: "The visibility of the repository, `public` or `private`",
+							Computed:            true,
+						},
+						"scan_on_push": schema.BoolAttribute{
+							MarkdownDescription: "Are the images scanned on push",
+							Computed:            true,
+						},
+						"immutable_tags": schema.BoolAttribute{
+							MarkdownDescription: "Are the tags immutable",
+							Computed:            true,
+						},
+						"short_description": schema.StringAttribute{
+							MarkdownDescription: "The short description of the repository",
+							Computed:            true,
+						},
+						"long_description": schema.StringAttribute{
+							MarkdownDescription: "The long description of the repository",
+							Computed:            true,
+						},
+						"tag_limit": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of tags of the repository, 0 being unlimited",
+							Computed:            true,
+						},
+						"pulls": schema.Int64Attribute{
+							MarkdownDescription: "The number of pulls from the repository",
+							Computed:            true,
+						},
+						"pushes": schema.Int64Attribute{
+							MarkdownDescription: "The number of pushes to the repository",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *reposDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read repos data source")
+	var data reposDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.Namespace.ValueString(),
+		data.Visibility.ValueString(),
+		data.NameRegex.ValueString(),
+		data.ScanOnPush.String(),
+	))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr repos datasource handler is in testing mode, no injestion will be run.")
+		data.Repos = []repoDataSourceModel{}
+	} else {
+		rRepos, err := d.client.ReadRepos(ctx, data.Namespace.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Repos",
+				err.Error(),
+			)
+			return
+		}
+
+		var nameRegex *regexp.Regexp
+		if !data.NameRegex.IsNull() {
+			nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+		}
+
+		repos := []repoDataSourceModel{}
+		for _, r := range rRepos {
+			if !data.matches(r, nameRegex) {
+				continue
+			}
+			repo := repoDataSourceModel{}
+			repo.fromRepo(r)
+			repos = append(repos, repo)
+		}
+		data.Repos = repos
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d repos in repos data source", len(repos)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading repos data source", map[string]any{"success": true})
+}
+
+// matches reports whether the repo meets the filters MSR doesn't apply.
+func (m *reposDataSourceModel) matches(repo client.ResponseRepo, nameRegex *regexp.Regexp) bool {
+	if !m.Visibility.IsNull() && m.Visibility.ValueString() != repo.Visibility {
+		return false
+	}
+	if !m.ScanOnPush.IsNull() && m.ScanOnPush.ValueBool() != repo.ScanOnPush {
+		return false
+	}
+	return nameRegex == nil || nameRegex.MatchString(repo.Name)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestReposDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The visibility is either public or private
+			{
+				Config: providerConfig + `
+				data "msr_repos" "test" {
+					visibility = "internal"
+				}`,
+				ExpectError: regexp.MustCompile("visibility"),
+			},
+			{
+				Config: providerConfig + `
+				data "msr_repos" "test" {
+					namespace    = "example"
+					visibility   = "private"
+					name_regex   = "^app-"
+					scan_on_push = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_repos.test", "repos.#", "0"),
+					// Verify the id is derived from the query
+					resource.TestCheckResourceAttr("data.msr_repos.test", "id", queryID("example", "private", "^app-", "true")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testUpgradeState upgrades the version 0 state of testdata/state_v0/<type name>.json
+// through the provider server, as Terraform does, and decodes the upgraded state.
+func testUpgradeState(t *testing.T, typeName string, r resource.Resource, upgraded any) {
+	t.Helper()
+	ctx := context.Background()
+
+	rawState, err := os.ReadFile(filepath.Join("testdata", "state_v0", typeName+".json"))
+	if err != nil {
+		t.Fatalf("reading the fixture: %s", err)
+	}
+
+	server := providerserver.NewProtocol6(New(TestingVersion)())()
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: rawState},
+	})
+	if err != nil {
+		t.Fatalf("upgrading the state: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("upgrading the state: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	raw, err := resp.UpgradedState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("decoding the upgraded state: %s", err)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+	if diags := state.Get(ctx, upgraded); diags.HasError() {
+		t.Fatalf("reading the upgraded state: %v", diags)
+	}
+}
+
+func TestUpgradeStateV0(t *testing.T) {
+	t.Run("org", func(t *testing.T) {
+		var upgraded OrgResourceModel
+		testUpgradeState(t, "msr_org", NewOrgResource(), &upgraded)
+		expected := OrgResourceModel{
+			Id:                 types.StringValue("o-1"),
+			Name:               types.StringValue("acme"),
+			DeletionProtection: types.BoolValue(false),
+			OnDestroy:          types.StringValue(onDestroyDelete),
+			Timeouts:           nullTimeouts(),
+		}
+		if !reflect.DeepEqual(upgraded, expected) {
+			t.Errorf("expected %+v, got %+v", expected, upgraded)
+		}
+	})
+
+	t.Run("repo", func(t *testing.T) {
+		var upgraded RepoResourceModel
+		testUpgradeState(t, "msr_repo", NewRepoResource(), &upgraded)
+		expected := RepoResourceModel{
+			Id:                       types.StringValue("r-1"),
+			Name:                     types.StringValue("app"),
+			OrgName:                  types.StringValue("acme"),
+			ScanOnPush:               types.BoolValue(true),
+			Visibility:               types.StringValue("public"),
+			ImmutableTags:            types.BoolValue(false),
+			PreventDestroyIfNotEmpty: types.BoolValue(false),
+			DeletionProtection:       types.BoolValue(false),
+			OnDestroy:                types.StringValue(onDestroyDelete),
+			Timeouts:                 nullTimeouts(),
+		}
+		if !reflect.DeepEqual(upgraded, expected) {
+			t.Errorf("expected %+v, got %+v", expected, upgraded)
+		}
+	})
+
+	t.Run("user", func(t *testing.T) {
+		var upgraded UserResourceModel
+		testUpgradeState(t, "msr_user", NewUserResource(), &upgraded)
+		expected := UserResourceModel{
+			Id:                 types.StringValue("u-1"),
+			Name:               types.StringValue("alice"),
+			Password:           types.StringValue("secret-pass"),
+			PasswordWO:         types.StringNull(),
+			PasswordWOVersion:  types.Int64Null(),
+			FullName:           types.StringValue("Alice"),
+			IsAdmin:            types.BoolValue(false),
+			IsActive:           types.BoolValue(true),
+			IsImported:         types.BoolNull(),
+			OtpEnabled:         types.BoolNull(),
+			TeamsCount:         types.Int64Null(),
+			DeletionProtection: types.BoolValue(false),
+			OnDestroy:          types.StringValue(onDestroyDelete),
+			Timeouts:           nullTimeouts(),
+		}
+		if !reflect.DeepEqual(upgraded, expected) {
+			t.Errorf("expected %+v, got %+v", expected, upgraded)
+		}
+	})
+
+	t.Run("team", func(t *testing.T) {
+		var upgraded TeamResourceModel
+		testUpgradeState(t, "msr_team", NewTeamResource(), &upgraded)
+		if upgraded.Id.ValueString() != "t-1" || upgraded.Name.ValueString() != "devs" || upgraded.OrgID.ValueString() != "acme" ||
+			upgraded.Description.ValueString() != "developers" || upgraded.LDAPSync != nil ||
+			upgraded.DeletionProtection.ValueBool() || upgraded.OnDestroy.ValueString() != onDestroyDelete {
+			t.Errorf("unexpected upgraded team %+v", upgraded)
+		}
+		// The members keep no order once in a set
+		userIDs, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"u-1", "u-2"})
+		if !upgraded.UserIDs.Equal(userIDs) {
+			t.Errorf("expected the user ids %s, got %s", userIDs, upgraded.UserIDs)
+		}
+	})
+
+	// The pruning policy schema is still at version 0, its states are read as they are
+	t.Run("pruning policy", func(t *testing.T) {
+		var upgraded PruningPolicyResourceModel
+		testUpgradeState(t, "msr_pruning_policy", NewPruningPolicyResource(), &upgraded)
+		if upgraded.Id.ValueString() != "p-1" || upgraded.OrgName.ValueString() != "acme" ||
+			upgraded.RepoName.ValueString() != "app" || !upgraded.Enabled.ValueBool() {
+			t.Errorf("unexpected upgraded policy %+v", upgraded)
+		}
+		if len(upgraded.Rules) != 1 || upgraded.Rules[0].Field.ValueString() != "tag" ||
+			upgraded.Rules[0].Operator.ValueString() != "matches" ||
+			len(upgraded.Rules[0].Values) != 1 || upgraded.Rules[0].Values[0].ValueString() != "^dev-" {
+			t.Errorf("unexpected upgraded rules %+v", upgraded.Rules)
+		}
+	})
+}
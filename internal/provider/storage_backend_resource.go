@@ -0,0 +1,636 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &StorageBackendResource{}
+	_ resource.ResourceWithConfigValidators = &StorageBackendResource{}
+	_ resource.ResourceWithIdentity         = &StorageBackendResource{}
+)
+
+// storageSettingsID names the single registry storage of MSR in the ID and the identity.
+const storageSettingsID = "storage"
+
+type StorageBackendResourceModel struct {
+	Id            types.String            `tfsdk:"id"`
+	DeleteEnabled types.Bool              `tfsdk:"delete_enabled"`
+	Filesystem    *storageFilesystemModel `tfsdk:"filesystem"`
+	S3            *storageS3Model         `tfsdk:"s3"`
+	Azure         *storageAzureModel      `tfsdk:"azure"`
+	GCS           *storageGCSModel        `tfsdk:"gcs"`
+	Swift         *storageSwiftModel      `tfsdk:"swift"`
+	Timeouts      timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// StorageBackendResourceIdentityModel identifies the single registry storage of MSR, whatever its driver.
+type StorageBackendResourceIdentityModel struct {
+	Settings types.String `tfsdk:"settings"`
+}
+
+type storageFilesystemModel struct {
+	RootDirectory types.String `tfsdk:"root_directory"`
+}
+
+type storageS3Model struct {
+	Region         types.String `tfsdk:"region"`
+	Bucket         types.String `tfsdk:"bucket"`
+	RegionEndpoint types.String `tfsdk:"region_endpoint"`
+	AccessKey      types.String `tfsdk:"access_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	RootDirectory  types.String `tfsdk:"root_directory"`
+	StorageClass   types.String `tfsdk:"storage_class"`
+	KeyID          types.String `tfsdk:"key_id"`
+	Encrypt        types.Bool   `tfsdk:"encrypt"`
+	Secure         types.Bool   `tfsdk:"secure"`
+	V4Auth         types.Bool   `tfsdk:"v4_auth"`
+}
+
+type storageAzureModel struct {
+	AccountName   types.String `tfsdk:"account_name"`
+	AccountKey    types.String `tfsdk:"account_key"`
+	Container     types.String `tfsdk:"container"`
+	Realm         types.String `tfsdk:"realm"`
+	RootDirectory types.String `tfsdk:"root_directory"`
+}
+
+type storageGCSModel struct {
+	Bucket          types.String `tfsdk:"bucket"`
+	RootDirectory   types.String `tfsdk:"root_directory"`
+	CredentialsJSON types.String `tfsdk:"credentials_json"`
+}
+
+type storageSwiftModel struct {
+	AuthURL            types.String `tfsdk:"auth_url"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	Container          types.String `tfsdk:"container"`
+	Region             types.String `tfsdk:"region"`
+	Tenant             types.String `tfsdk:"tenant"`
+	TenantID           types.String `tfsdk:"tenant_id"`
+	Domain             types.String `tfsdk:"domain"`
+	DomainID           types.String `tfsdk:"domain_id"`
+	Prefix             types.String `tfsdk:"prefix"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+type StorageBackendResource struct {
+	client client.Client
+}
+
+func NewStorageBackendResource() resource.Resource {
+	return &StorageBackendResource{}
+}
+
+func (r *StorageBackendResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_backend"
+}
+
+func (r *StorageBackendResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage backend resource. There is a single storage backend per MSR instance, exactly one of the storage blocks must be set",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Allow deleting image data from the storage, required by garbage collection",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+			"filesystem": schema.SingleNestedBlock{
+				MarkdownDescription: "Local filesystem storage",
+				Attributes: map[string]schema.Attribute{
+					"root_directory": schema.StringAttribute{
+						MarkdownDescription: "The directory images are stored in",
+						Optional:            true,
+					},
+				},
+			},
+			"s3": schema.SingleNestedBlock{
+				MarkdownDescription: "Amazon S3 or S3 compatible storage",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("bucket")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						MarkdownDescription: "The bucket images are stored in",
+						Optional:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The region of the bucket",
+						Optional:            true,
+					},
+					"region_endpoint": schema.StringAttribute{
+						MarkdownDescription: "The endpoint of S3 compatible storage services",
+						Optional:            true,
+					},
+					"access_key": schema.StringAttribute{
+						MarkdownDescription: "The access key, instance credentials are used when unset",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"secret_key": schema.StringAttribute{
+						MarkdownDescription: "The secret key, instance credentials are used when unset",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"root_directory": schema.StringAttribute{
+						MarkdownDescription: "The prefix applied to the stored objects",
+						Optional:            true,
+					},
+					"storage_class": schema.StringAttribute{
+						MarkdownDescription: "The storage class applied to the stored objects",
+						Optional:            true,
+					},
+					"key_id": schema.StringAttribute{
+						MarkdownDescription: "The KMS key used to encrypt the stored objects",
+						Optional:            true,
+					},
+					"encrypt": schema.BoolAttribute{
+						MarkdownDescription: "Encrypt the stored objects server side",
+						Optional:            true,
+					},
+					"secure": schema.BoolAttribute{
+						MarkdownDescription: "Use HTTPS to reach the storage",
+						Optional:            true,
+					},
+					"v4_auth": schema.BoolAttribute{
+						MarkdownDescription: "Use AWS signature version 4",
+						Optional:            true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedBlock{
+				MarkdownDescription: "Azure blob storage",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(
+						path.MatchRelative().AtName("account_name"),
+						path.MatchRelative().AtName("container"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"account_name": schema.StringAttribute{
+						MarkdownDescription: "The storage account name",
+						Optional:            true,
+					},
+					"account_key": schema.StringAttribute{
+						MarkdownDescription: "The storage account key",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"container": schema.StringAttribute{
+						MarkdownDescription: "The container images are stored in",
+						Optional:            true,
+					},
+					"realm": schema.StringAttribute{
+						MarkdownDescription: "The domain of the Azure cloud, e.g. `core.windows.net`",
+						Optional:            true,
+					},
+					"root_directory": schema.StringAttribute{
+						MarkdownDescription: "The prefix applied to the stored blobs",
+						Optional:            true,
+					},
+				},
+			},
+			"gcs": schema.SingleNestedBlock{
+				MarkdownDescription: "Google Cloud Storage",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("bucket")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						MarkdownDescription: "The bucket images are stored in",
+						Optional:            true,
+					},
+					"root_directory": schema.StringAttribute{
+						MarkdownDescription: "The prefix applied to the stored objects",
+						Optional:            true,
+					},
+					"credentials_json": schema.StringAttribute{
+						MarkdownDescription: "The service account key in JSON format",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"swift": schema.SingleNestedBlock{
+				MarkdownDescription: "OpenStack Swift storage",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(
+						path.MatchRelative().AtName("auth_url"),
+						path.MatchRelative().AtName("username"),
+						path.MatchRelative().AtName("container"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"auth_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the authentication service",
+						Optional:            true,
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The username",
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"container": schema.StringAttribute{
+						MarkdownDescription: "The container images are stored in",
+						Optional:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The region of the container",
+						Optional:            true,
+					},
+					"tenant": schema.StringAttribute{
+						MarkdownDescription: "The tenant name",
+						Optional:            true,
+					},
+					"tenant_id": schema.StringAttribute{
+						MarkdownDescription: "The tenant ID",
+						Optional:            true,
+					},
+					"domain": schema.StringAttribute{
+						MarkdownDescription: "The domain name",
+						Optional:            true,
+					},
+					"domain_id": schema.StringAttribute{
+						MarkdownDescription: "The domain ID",
+						Optional:            true,
+					},
+					"prefix": schema.StringAttribute{
+						MarkdownDescription: "The prefix applied to the stored objects",
+						Optional:            true,
+					},
+					"insecure_skip_verify": schema.BoolAttribute{
+						MarkdownDescription: "Skip the TLS verification of the authentication service",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StorageBackendResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"settings": identityschema.StringAttribute{
+				Description:       "The MSR settings holding the storage, always `storage`",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *StorageBackendResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("filesystem"),
+			path.MatchRoot("s3"),
+			path.MatchRoot("azure"),
+			path.MatchRoot("gcs"),
+			path.MatchRoot("swift"),
+		),
+	}
+}
+
+func (r *StorageBackendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StorageBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageBackendResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storage, diags := data.toStorage()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr storage backend resource handler is in testing mode, no creation will be run.")
+		data.Id = basetypes.NewStringValue(TestingVersion)
+	} else {
+		rStorage, err := r.client.UpdateStorageSettings(ctx, storage)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Create storage backend error",
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("configured `%s` storage backend", rStorage.Driver()))
+		data.fromStorage(rStorage)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *StorageBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read storage backend resource")
+	var data *StorageBackendResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr storage backend resource handler is in testing mode, no read will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rStorage, err := r.client.ReadStorageSettings(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromStorage(rStorage)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+}
+
+func (r *StorageBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update storage backend resource")
+
+	var data *StorageBackendResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storage, diags := data.toStorage()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr storage backend resource handler is in testing mode, no update will be run.")
+		data.Id = types.StringValue(TestingVersion)
+	} else {
+		rStorage, err := r.client.UpdateStorageSettings(ctx, storage)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.fromStorage(rStorage)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	tflog.Debug(ctx, "Updated 'storage backend' resource", map[string]any{"success": true})
+}
+
+func (r *StorageBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// MSR always has a storage backend, the configuration is left in place.
+	tflog.Trace(ctx, "No action taken. storage backend resource is removed from the state only.")
+}
+
+func (r *StorageBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The storage is read whatever the ID
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("settings"), req, resp)
+}
+
+// identity returns the identity of the registry storage.
+func (m *StorageBackendResourceModel) identity() StorageBackendResourceIdentityModel {
+	return StorageBackendResourceIdentityModel{
+		Settings: types.StringValue(storageSettingsID),
+	}
+}
+
+// toStorage converts the model to the MSR registry storage settings.
+func (m *StorageBackendResourceModel) toStorage() (client.RegistryStorage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	storage := client.RegistryStorage{
+		Delete: &client.StorageDelete{Enabled: m.DeleteEnabled.ValueBool()},
+	}
+
+	switch {
+	case m.Filesystem != nil:
+		storage.Filesystem = &client.StorageFilesystemDriver{
+			RootDirectory: m.Filesystem.RootDirectory.ValueString(),
+		}
+	case m.S3 != nil:
+		storage.S3 = &client.StorageS3Driver{
+			Region:         m.S3.Region.ValueString(),
+			Bucket:         m.S3.Bucket.ValueString(),
+			RegionEndpoint: m.S3.RegionEndpoint.ValueString(),
+			AccessKey:      m.S3.AccessKey.ValueString(),
+			SecretKey:      m.S3.SecretKey.ValueString(),
+			RootDirectory:  m.S3.RootDirectory.ValueString(),
+			StorageClass:   m.S3.StorageClass.ValueString(),
+			KeyID:          m.S3.KeyID.ValueString(),
+			Encrypt:        m.S3.Encrypt.ValueBool(),
+			Secure:         m.S3.Secure.ValueBool(),
+			V4Auth:         m.S3.V4Auth.ValueBool(),
+		}
+	case m.Azure != nil:
+		storage.Azure = &client.StorageAzureDriver{
+			AccountName:   m.Azure.AccountName.ValueString(),
+			AccountKey:    m.Azure.AccountKey.ValueString(),
+			Container:     m.Azure.Container.ValueString(),
+			Realm:         m.Azure.Realm.ValueString(),
+			RootDirectory: m.Azure.RootDirectory.ValueString(),
+		}
+	case m.GCS != nil:
+		storage.GCS = &client.StorageGCSDriver{
+			Bucket:        m.GCS.Bucket.ValueString(),
+			RootDirectory: m.GCS.RootDirectory.ValueString(),
+		}
+		if creds := m.GCS.CredentialsJSON.ValueString(); creds != "" {
+			if err := json.Unmarshal([]byte(creds), &storage.GCS.Credentials); err != nil {
+				diags.AddAttributeError(
+					path.Root("gcs").AtName("credentials_json"),
+					"Invalid GCS credentials",
+					fmt.Sprintf("The credentials must be a service account key in JSON format: %s", err),
+				)
+			}
+		}
+	case m.Swift != nil:
+		storage.Swift = &client.StorageSwiftDriver{
+			AuthURL:            m.Swift.AuthURL.ValueString(),
+			Username:           m.Swift.Username.ValueString(),
+			Password:           m.Swift.Password.ValueString(),
+			Container:          m.Swift.Container.ValueString(),
+			Region:             m.Swift.Region.ValueString(),
+			Tenant:             m.Swift.Tenant.ValueString(),
+			TenantID:           m.Swift.TenantID.ValueString(),
+			Domain:             m.Swift.Domain.ValueString(),
+			DomainID:           m.Swift.DomainID.ValueString(),
+			Prefix:             m.Swift.Prefix.ValueString(),
+			InsecureSkipVerify: m.Swift.InsecureSkipVerify.ValueBool(),
+		}
+	}
+
+	return storage, diags
+}
+
+// fromStorage refreshes the model from the MSR registry storage settings.
+// Secrets MSR doesn't return keep their prior value.
+func (m *StorageBackendResourceModel) fromStorage(storage client.RegistryStorage) {
+	m.Id = types.StringValue(storageSettingsID)
+	m.DeleteEnabled = types.BoolValue(storage.Delete != nil && storage.Delete.Enabled)
+
+	prior := *m
+	m.Filesystem, m.S3, m.Azure, m.GCS, m.Swift = nil, nil, nil, nil, nil
+
+	switch {
+	case storage.Filesystem != nil:
+		p := prior.Filesystem
+		if p == nil {
+			p = &storageFilesystemModel{}
+		}
+		m.Filesystem = &storageFilesystemModel{
+			RootDirectory: optionalString(p.RootDirectory, storage.Filesystem.RootDirectory),
+		}
+	case storage.S3 != nil:
+		p := prior.S3
+		if p == nil {
+			p = &storageS3Model{}
+		}
+		m.S3 = &storageS3Model{
+			Region:         optionalString(p.Region, storage.S3.Region),
+			Bucket:         optionalString(p.Bucket, storage.S3.Bucket),
+			RegionEndpoint: optionalString(p.RegionEndpoint, storage.S3.RegionEndpoint),
+			AccessKey:      secretString(p.AccessKey, storage.S3.AccessKey),
+			SecretKey:      secretString(p.SecretKey, storage.S3.SecretKey),
+			RootDirectory:  optionalString(p.RootDirectory, storage.S3.RootDirectory),
+			StorageClass:   optionalString(p.StorageClass, storage.S3.StorageClass),
+			KeyID:          optionalString(p.KeyID, storage.S3.KeyID),
+			Encrypt:        optionalBool(p.Encrypt, storage.S3.Encrypt),
+			Secure:         optionalBool(p.Secure, storage.S3.Secure),
+			V4Auth:         optionalBool(p.V4Auth, storage.S3.V4Auth),
+		}
+	case storage.Azure != nil:
+		p := prior.Azure
+		if p == nil {
+			p = &storageAzureModel{}
+		}
+		m.Azure = &storageAzureModel{
+			AccountName:   optionalString(p.AccountName, storage.Azure.AccountName),
+			AccountKey:    secretString(p.AccountKey, storage.Azure.AccountKey),
+			Container:     optionalString(p.Container, storage.Azure.Container),
+			Realm:         optionalString(p.Realm, storage.Azure.Realm),
+			RootDirectory: optionalString(p.RootDirectory, storage.Azure.RootDirectory),
+		}
+	case storage.GCS != nil:
+		p := prior.GCS
+		if p == nil {
+			p = &storageGCSModel{}
+		}
+		m.GCS = &storageGCSModel{
+			Bucket:        optionalString(p.Bucket, storage.GCS.Bucket),
+			RootDirectory: optionalString(p.RootDirectory, storage.GCS.RootDirectory),
+			// The credentials are kept as configured, MSR may reorder or redact their fields
+			CredentialsJSON: p.CredentialsJSON,
+		}
+	case storage.Swift != nil:
+		p := prior.Swift
+		if p == nil {
+			p = &storageSwiftModel{}
+		}
+		m.Swift = &storageSwiftModel{
+			AuthURL:            optionalString(p.AuthURL, storage.Swift.AuthURL),
+			Username:           optionalString(p.Username, storage.Swift.Username),
+			Password:           secretString(p.Password, storage.Swift.Password),
+			Container:          optionalString(p.Container, storage.Swift.Container),
+			Region:             optionalString(p.Region, storage.Swift.Region),
+			Tenant:             optionalString(p.Tenant, storage.Swift.Tenant),
+			TenantID:           optionalString(p.TenantID, storage.Swift.TenantID),
+			Domain:             optionalString(p.Domain, storage.Swift.Domain),
+			DomainID:           optionalString(p.DomainID, storage.Swift.DomainID),
+			Prefix:             optionalString(p.Prefix, storage.Swift.Prefix),
+			InsecureSkipVerify: optionalBool(p.InsecureSkipVerify, storage.Swift.InsecureSkipVerify),
+		}
+	}
+}
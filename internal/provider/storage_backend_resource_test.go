@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestStorageBackendResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Exactly one storage block is required
+			{
+				Config: providerConfig + `
+				resource "msr_storage_backend" "test" {
+					filesystem {
+						root_directory = "/storage"
+					}
+					s3 {
+						bucket = "msr"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Each driver requires its own settings
+			{
+				Config: providerConfig + `
+				resource "msr_storage_backend" "test" {
+					azure {
+						account_name = "msr"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`azure.container" must be specified`),
+			},
+			{
+				Config: providerConfig + `
+				resource "msr_storage_backend" "test" {
+					s3 {
+						region = "us-east-1"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`s3.bucket" must be specified`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "msr_storage_backend" "test" {
+					filesystem {
+						root_directory = "/storage"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_storage_backend.test", "filesystem.root_directory", "/storage"),
+					resource.TestCheckResourceAttr("msr_storage_backend.test", "delete_enabled", "true"),
+					resource.TestCheckResourceAttr("msr_storage_backend.test", "id", TestingVersion),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "msr_storage_backend" "test" {
+					delete_enabled = false
+					s3 {
+						bucket     = "msr"
+						region     = "us-east-1"
+						access_key = "fakeaccesskey"
+						secret_key = "fakesecretkey"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_storage_backend.test", "s3.bucket", "msr"),
+					resource.TestCheckResourceAttr("msr_storage_backend.test", "s3.secret_key", "fakesecretkey"),
+					resource.TestCheckResourceAttr("msr_storage_backend.test", "delete_enabled", "false"),
+					resource.TestCheckNoResourceAttr("msr_storage_backend.test", "filesystem.root_directory"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
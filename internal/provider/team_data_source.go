@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &teamDataSource{}
+)
+
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+type teamDataSource struct {
+	client client.Client
+}
+
+// teamDataSourceModel maps the data source schema data.
+type teamDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Org          types.String `tfsdk:"org"`
+	Name         types.String `tfsdk:"name"`
+	OrgID        types.String `tfsdk:"org_id"`
+	Description  types.String `tfsdk:"description"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *teamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Team data source. Looks up a team of an organization by name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the team",
+			},
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the organization of the team",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the team",
+				Required:            true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization of the team",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the team",
+				Computed:            true,
+			},
+			"members_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of members of the team",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read team data source")
+	var data teamDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr team datasource handler is in testing mode, no injestion will be run.")
+		data.fromTeam(client.Team{ID: TestingVersion, Name: data.Name.ValueString(), OrgID: TestingVersion})
+	} else {
+		rTeam, err := d.client.ReadTeam(ctx, data.Org.ValueString(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Team",
+				err.Error(),
+			)
+			return
+		}
+		data.fromTeam(rTeam)
+
+		tflog.Trace(ctx, fmt.Sprintf("read in team data source `%s`", rTeam.ID))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading team data source", map[string]any{"success": true})
+}
+
+// fromTeam fills the model from the enzi team, the org lookup key is kept as configured.
+func (m *teamDataSourceModel) fromTeam(team client.Team) {
+	m.ID = types.StringValue(team.ID)
+	m.Name = types.StringValue(team.Name)
+	m.OrgID = types.StringValue(team.OrgID)
+	m.Description = types.StringValue(team.Description)
+	m.MembersCount = types.Int64Value(int64(team.MembersCount))
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTeamDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_team" "test" {
+					org  = "example"
+					name = "developers"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_team.test", "name", "developers"),
+					resource.TestCheckResourceAttr("data.msr_team.test", "org", "example"),
+					resource.TestCheckResourceAttr("data.msr_team.test", "org_id", TestingVersion),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.msr_team.test", "id", TestingVersion),
+				),
+			},
+		},
+	})
+}
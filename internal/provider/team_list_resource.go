@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &TeamListResource{}
+	_ list.ListResourceWithConfigure = &TeamListResource{}
+)
+
+type TeamListResourceModel struct {
+	Org       types.String `tfsdk:"org"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// TeamListResource lists the teams of an organization for `terraform query`.
+type TeamListResource struct {
+	client client.Client
+}
+
+func NewTeamListResource() list.ListResource {
+	return &TeamListResource{}
+}
+
+func (r *TeamListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *TeamListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the teams of an organization, to import them",
+
+		Attributes: map[string]listschema.Attribute{
+			"org": listschema.StringAttribute{
+				MarkdownDescription: "The name or ID of the organization",
+				Required:            true,
+			},
+			"name_regex": listschema.StringAttribute{
+				MarkdownDescription: "Only list the teams whose name matches the regular expression",
+				Optional:            true,
+				Validators:          []validator.String{regexValidator{}},
+			},
+		},
+	}
+}
+
+func (r *TeamListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data TeamListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var teams []client.Team
+	if r.client.TestMode {
+		diags.AddWarning("testing mode warning", "msr team list resource handler is in testing mode, no listing will be run.")
+		teams = []client.Team{{ID: TestingVersion, Name: TestingVersion, OrgID: TestingVersion, Description: TestingVersion}}
+	} else {
+		var err error
+		teams, err = r.client.ReadTeams(ctx, data.Org.ValueString())
+		if err != nil {
+			diags.AddError("Unable to List Teams", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	nameRegex := listNameRegex(data.NameRegex)
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for _, team := range teams {
+			if listLimitReached(req, count) {
+				return
+			}
+			if nameRegex != nil && !nameRegex.MatchString(team.Name) {
+				continue
+			}
+
+			model := TeamResourceModel{
+				OrgID:              types.StringValue(team.OrgID),
+				UserIDs:            types.SetNull(types.StringType),
+				DeletionProtection: types.BoolValue(false),
+				OnDestroy:          types.StringValue(onDestroyDelete),
+				Timeouts:           nullTimeouts(),
+			}
+			model.fromTeam(team)
+
+			result := newListResult(ctx, req, data.Org.ValueString()+"/"+team.Name, model.identity(), model)
+			result.Diagnostics.Append(diags...)
+
+			// The LDAP sync of the team is one more request, only made when the resource is wanted
+			if req.IncludeResource && !r.client.TestMode {
+				opts, err := r.client.ReadTeamMemberSyncConfig(ctx, team.OrgID, team.ID)
+				if err != nil {
+					result.Diagnostics.AddError("Unable to List Teams", err.Error())
+				} else {
+					model.LDAPSync = fromMemberSyncOpts(nil, opts)
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTeamListResource(t *testing.T) {
+	ctx := context.Background()
+	server := newTestMSRServer(t)
+
+	results := testListResults(t, server, NewTeamListResource(), NewTeamResource(), map[string]string{"org": "acme"}, true, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 teams, got %d", len(results))
+	}
+	if results[0].DisplayName != "acme/devs" {
+		t.Errorf("expected the acme/devs display name, got %q", results[0].DisplayName)
+	}
+
+	var identity TeamResourceIdentityModel
+	if diags := results[0].Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("reading the identity: %v", diags)
+	}
+	if identity.OrgID.ValueString() != "o-1" || identity.TeamID.ValueString() != "t-1" {
+		t.Errorf("expected the o-1/t-1 identity, got %s/%s", identity.OrgID, identity.TeamID)
+	}
+
+	var model TeamResourceModel
+	if diags := results[0].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading the resource: %v", diags)
+	}
+	if model.Name.ValueString() != "devs" || model.Description.ValueString() != "developers" || model.LDAPSync != nil {
+		t.Errorf("unexpected resource %+v", model)
+	}
+
+	results = testListResults(t, server, NewTeamListResource(), NewTeamResource(), map[string]string{"org": "acme", "name_regex": "^ops$"}, false, 0)
+	if len(results) != 1 || results[0].DisplayName != "acme/ops" {
+		t.Errorf("expected only acme/ops, got %d results", len(results))
+	}
+}
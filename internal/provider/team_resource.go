@@ -3,29 +3,65 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &TeamResource{}
+var (
+	_ resource.Resource                 = &TeamResource{}
+	_ resource.ResourceWithModifyPlan   = &TeamResource{}
+	_ resource.ResourceWithIdentity     = &TeamResource{}
+	_ resource.ResourceWithUpgradeState = &TeamResource{}
+)
 
 type TeamResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	OrgID       types.String `tfsdk:"org_id"`
-	Description types.String `tfsdk:"description"`
-	UserIDs     types.List   `tfsdk:"user_ids"`
-	Id          types.String `tfsdk:"id"`
+	Name        types.String       `tfsdk:"name"`
+	OrgID       types.String       `tfsdk:"org_id"`
+	Description types.String       `tfsdk:"description"`
+	UserIDs     types.Set          `tfsdk:"user_ids"`
+	LDAPSync    *teamLDAPSyncModel `tfsdk:"ldap_sync"`
+	// Provider side attributes, they aren't sent to MSR
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	OnDestroy          types.String   `tfsdk:"on_destroy"`
+	Id                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type teamLDAPSyncModel struct {
+	SyncMode           types.String `tfsdk:"sync_mode"`
+	GroupDN            types.String `tfsdk:"group_dn"`
+	GroupMemberAttr    types.String `tfsdk:"group_member_attr"`
+	SearchBaseDN       types.String `tfsdk:"search_base_dn"`
+	SearchScopeSubtree types.Bool   `tfsdk:"search_scope_subtree"`
+	SearchFilter       types.String `tfsdk:"search_filter"`
+}
+
+// Team LDAP sync modes.
+const (
+	ldapSyncModeGroup  = "group"
+	ldapSyncModeSearch = "search"
+)
+
+// TeamResourceIdentityModel identifies a team by its organization and ID.
+type TeamResourceIdentityModel struct {
+	OrgID  types.String `tfsdk:"org_id"`
+	TeamID types.String `tfsdk:"team_id"`
 }
 
 type TeamResource struct {
@@ -43,6 +79,7 @@ func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 
 		Attributes: map[string]schema.Attribute{
@@ -58,8 +95,11 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Required:            true,
 			},
 			"org_id": schema.StringAttribute{
-				MarkdownDescription: "The organization id for the team",
+				MarkdownDescription: "The organization id for the team. MSR can't move teams, changing it replaces the team",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the team",
@@ -67,18 +107,145 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
-			"user_ids": schema.ListAttribute{
-				MarkdownDescription: "The user ids belonging to the team",
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "The user ids belonging to the team. Conflicts with `ldap_sync`, which manages the members",
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+				Default:             setdefault.StaticValue(types.SetNull(types.StringType)),
+				Validators:          []validator.Set{setvalidator.ConflictsWith(path.MatchRoot("ldap_sync"))},
+			},
+			"deletion_protection": deletionProtectionAttribute(),
+			"on_destroy":          onDestroyAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+			"ldap_sync": schema.SingleNestedBlock{
+				MarkdownDescription: "Sync the team members with LDAP, requires `msr_ldap_settings`. " +
+					"The members are synced on the LDAP sync schedule, use `msr_ldap_sync_run` to sync immediately",
+				Attributes: map[string]schema.Attribute{
+					"sync_mode": schema.StringAttribute{
+						MarkdownDescription: "`group` syncs the members of `group_dn`, `search` syncs the users found by the search. Defaults to `group`",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(ldapSyncModeGroup, ldapSyncModeSearch)},
+					},
+					"group_dn": schema.StringAttribute{
+						MarkdownDescription: "The distinguished name of the group whose members are synced",
+						Optional:            true,
+					},
+					"group_member_attr": schema.StringAttribute{
+						MarkdownDescription: "The attribute of the group listing its members, e.g. `member`",
+						Optional:            true,
+					},
+					"search_base_dn": schema.StringAttribute{
+						MarkdownDescription: "The distinguished name the search starts from",
+						Optional:            true,
+					},
+					"search_scope_subtree": schema.BoolAttribute{
+						MarkdownDescription: "Search the whole subtree instead of the direct children of `search_base_dn`",
+						Optional:            true,
+					},
+					"search_filter": schema.StringAttribute{
+						MarkdownDescription: "The LDAP filter the members must match",
+						Optional:            true,
+					},
+				},
 			},
 		},
 		MarkdownDescription: "Team resource",
 	}
 }
 
+// teamResourceModelV0 is the team state before ldap_sync and the provider side attributes, with the user ids as a list.
+type teamResourceModelV0 struct {
+	Name        types.String `tfsdk:"name"`
+	OrgID       types.String `tfsdk:"org_id"`
+	Description types.String `tfsdk:"description"`
+	UserIDs     types.List   `tfsdk:"user_ids"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (r *TeamResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 turns user_ids into a set, the members aren't ordered, and adds ldap_sync,
+		// deletion_protection and on_destroy
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Required: true},
+					"org_id":      schema.StringAttribute{Required: true},
+					"description": schema.StringAttribute{Optional: true, Computed: true},
+					"user_ids":    schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior teamResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				userIDs := types.SetNull(types.StringType)
+				if !prior.UserIDs.IsNull() {
+					var diags diag.Diagnostics
+					userIDs, diags = types.SetValue(types.StringType, prior.UserIDs.Elements())
+					resp.Diagnostics.Append(diags...)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, TeamResourceModel{
+					Name:               prior.Name,
+					OrgID:              prior.OrgID,
+					Description:        prior.Description,
+					UserIDs:            userIDs,
+					DeletionProtection: types.BoolValue(false),
+					OnDestroy:          types.StringValue(onDestroyDelete),
+					Timeouts:           nullTimeouts(),
+					Id:                 prior.Id,
+				})...)
+			},
+		},
+	}
+}
+
+func (r *TeamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.StringAttribute{
+				Description:       "The organization id for the team",
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       "The ID of the team",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *TeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to warn about on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *TeamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.OrgID.Equal(plan.OrgID) {
+		resp.Diagnostics.AddWarning(
+			"Team replacement",
+			fmt.Sprintf("MSR can't move teams. Team %s will be deleted from %s along with its members and its permissions on namespaces and repos, "+
+				"and created in %s with the configured members only.",
+				state.Name.ValueString(), state.OrgID.ValueString(), plan.OrgID.ValueString()),
+		)
+	}
+}
+
 func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -104,6 +271,13 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// // Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	team := client.Team{
 		OrgID:       data.OrgID.ValueString(),
 		Description: data.Description.ValueString(),
@@ -131,7 +305,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		data.Id = basetypes.NewStringValue(rTeam.ID)
 
 		var usersSlice []string
-		data.UserIDs.ElementsAs(ctx, usersSlice, false)
+		resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &usersSlice, false)...)
 
 		for _, id := range usersSlice {
 			u := client.ResponseAccount{
@@ -146,9 +320,21 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 			}
 			tflog.Trace(ctx, fmt.Sprintf("added user `%s` to team `%s`", id, data.Name.ValueString()))
 		}
+
+		if data.LDAPSync != nil {
+			if _, err := r.client.UpdateTeamMemberSyncConfig(ctx, data.OrgID.ValueString(), data.Id.ValueString(), data.LDAPSync.toMemberSyncOpts()); err != nil {
+				resp.Diagnostics.AddError(
+					"Unexpected UpdateTeamMemberSyncConfig error",
+					err.Error(),
+				)
+				return
+			}
+			tflog.Trace(ctx, fmt.Sprintf("enabled LDAP sync of team `%s`", data.Name.ValueString()))
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -162,11 +348,23 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr team resource handler is in testing mode, no read will be run.")
 		data.Id = types.StringValue(TestingVersion)
 	} else {
-		t, err := r.client.ReadTeam(ctx, data.OrgID.ValueString(), data.Name.ValueString())
+		// The team is looked up by name, or by the ID given on import, until its ID is known
+		teamRef := data.Name.ValueString()
+		if data.Id.ValueString() != "" {
+			teamRef = data.Id.ValueString()
+		}
+		t, err := r.client.ReadTeam(ctx, data.OrgID.ValueString(), teamRef)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unexpected ReadTeam error",
@@ -174,27 +372,52 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			)
 			return
 		}
-		data.Id = types.StringValue(t.ID)
-		data.Name = types.StringValue(t.Name)
-		data.Description = types.StringValue(t.Description)
+		data.fromTeam(t)
+
+		opts, err := r.client.ReadTeamMemberSyncConfig(ctx, data.OrgID.ValueString(), t.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.LDAPSync = fromMemberSyncOpts(data.LDAPSync, opts)
+
+		// The members of a team synced with LDAP are left to the sync
+		if data.LDAPSync == nil {
+			tUsers, err := r.client.GetTeamUsers(ctx, data.OrgID.ValueString(), t.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
+			data.UserIDs, diags = fromTeamUsers(ctx, data.UserIDs, tUsers)
+			resp.Diagnostics.Append(diags...)
+		}
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Preparing to update team resource")
 
-	var data *TeamResourceModel
+	var data, state *TeamResourceModel
 
-	// Read Terraform prior state data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr team resource handler is in testing mode, no update will be run.")
 		data.Id = types.StringValue(TestingVersion)
@@ -215,17 +438,33 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		data.Name = types.StringValue(rTeam.Name)
 		data.Description = types.StringValue(rTeam.Description)
 
-		var users []string
-		data.UserIDs.ElementsAs(ctx, users, false)
-		if err := r.client.UpdateTeamUsers(ctx, data.OrgID.ValueString(), data.Id.ValueString(), users); err != nil {
-			resp.Diagnostics.AddError("Client Error", err.Error())
-			return
+		// The members of a team synced with LDAP are left to the sync
+		if data.LDAPSync == nil {
+			var users []string
+			resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &users, false)...)
+			if err := r.client.UpdateTeamUsers(ctx, data.OrgID.ValueString(), data.Id.ValueString(), users); err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Updated the users of the %s/%s team", data.OrgID, data.Name), map[string]any{"success": true})
+		}
+
+		// Removing the block turns the sync off
+		if data.LDAPSync != nil || state.LDAPSync != nil {
+			opts := client.MemberSyncOpts{}
+			if data.LDAPSync != nil {
+				opts = data.LDAPSync.toMemberSyncOpts()
+			}
+			if _, err := r.client.UpdateTeamMemberSyncConfig(ctx, data.OrgID.ValueString(), data.Id.ValueString(), opts); err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
 		}
-		tflog.Debug(ctx, fmt.Sprintf("Updated the users of the %s/%s team", data.OrgID, data.Name), map[string]any{"success": true})
 	}
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	tflog.Debug(ctx, "Updated 'team' resource", map[string]any{"success": true})
 }
 
@@ -235,6 +474,23 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTeam, diags := checkDestroy(ctx, "team", data.Name.ValueString(), data.DeletionProtection, data.OnDestroy)
+	resp.Diagnostics.Append(diags...)
+	if !deleteTeam {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr user resource handler is in testing mode, no deletion will be run.")
 	} else if err := r.client.DeleteTeam(ctx, data.OrgID.ValueString(), data.Id.ValueString()); err != nil {
@@ -246,17 +502,93 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity TeamResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), identity.OrgID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.TeamID)...)
+		resp.Diagnostics.Append(importDestroyDefaults(ctx, &resp.State)...)
+		return
+	}
 
-	idParts := strings.Split(req.ID, ",")
+	idParts := splitImportID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: org_id,team_name. Got: %q", req.ID),
-		)
+		resp.Diagnostics.Append(importIDError("org_id/team_name or org_id/team_id", req.ID)...)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(importDestroyDefaults(ctx, &resp.State)...)
+}
+
+// toMemberSyncOpts converts the LDAP sync block to the enzi member sync options.
+func (m *teamLDAPSyncModel) toMemberSyncOpts() client.MemberSyncOpts {
+	return client.MemberSyncOpts{
+		EnableSync:         true,
+		SelectGroupMembers: m.SyncMode.ValueString() != ldapSyncModeSearch,
+		GroupDN:            m.GroupDN.ValueString(),
+		GroupMemberAttr:    m.GroupMemberAttr.ValueString(),
+		SearchBaseDN:       m.SearchBaseDN.ValueString(),
+		SearchScopeSubtree: m.SearchScopeSubtree.ValueBool(),
+		SearchFilter:       m.SearchFilter.ValueString(),
+	}
+}
+
+// fromTeam sets the MSR managed values of the model from a team.
+func (m *TeamResourceModel) fromTeam(team client.Team) {
+	m.Id = types.StringValue(team.ID)
+	m.Name = types.StringValue(team.Name)
+	m.Description = types.StringValue(team.Description)
+}
+
+// fromTeamUsers returns the user ids of the team members.
+// A team without members keeps null user ids when they were unset.
+func fromTeamUsers(ctx context.Context, prior types.Set, tUsers client.TeamUsers) (types.Set, diag.Diagnostics) {
+	if len(tUsers.Members) == 0 && prior.IsNull() {
+		return prior, nil
+	}
+	ids := make([]string, 0, len(tUsers.Members))
+	for _, m := range tUsers.Members {
+		ids = append(ids, m.Member.ID)
+	}
+	return types.SetValueFrom(ctx, types.StringType, ids)
+}
+
+// identity returns the identity of the team.
+func (m *TeamResourceModel) identity() TeamResourceIdentityModel {
+	return TeamResourceIdentityModel{
+		OrgID:  m.OrgID,
+		TeamID: m.Id,
+	}
+}
+
+// fromMemberSyncOpts returns the LDAP sync block read from enzi, nil when the sync is off.
+// Attributes left unset keep being null when enzi reports their zero value.
+func fromMemberSyncOpts(prior *teamLDAPSyncModel, opts client.MemberSyncOpts) *teamLDAPSyncModel {
+	if !opts.EnableSync {
+		return nil
+	}
+	if prior == nil {
+		prior = &teamLDAPSyncModel{}
+	}
+
+	mode := types.StringValue(ldapSyncModeSearch)
+	if opts.SelectGroupMembers {
+		// group is the default mode, it stays null when unset
+		mode = types.StringValue(ldapSyncModeGroup)
+		if prior.SyncMode.IsNull() {
+			mode = prior.SyncMode
+		}
+	}
+
+	return &teamLDAPSyncModel{
+		SyncMode:           mode,
+		GroupDN:            optionalString(prior.GroupDN, opts.GroupDN),
+		GroupMemberAttr:    optionalString(prior.GroupMemberAttr, opts.GroupMemberAttr),
+		SearchBaseDN:       optionalString(prior.SearchBaseDN, opts.SearchBaseDN),
+		SearchScopeSubtree: optionalBool(prior.SearchScopeSubtree, opts.SearchScopeSubtree),
+		SearchFilter:       optionalString(prior.SearchFilter, opts.SearchFilter),
+	}
 }
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTeamMembersServer starts a fake enzi holding the members of the acme/devs team.
+func newTeamMembersServer(t *testing.T, members *[]string) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	team := client.Team{ID: "t-1", Name: "devs", OrgID: "o-1"}
+	reply := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Errorf("encoding the fake enzi response: %s", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /enzi/v0/accounts/{org}/teams", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, team)
+	})
+	mux.HandleFunc("PATCH /enzi/v0/accounts/{org}/teams/{team}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, team)
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{account}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, client.ResponseAccount{ID: r.PathValue("account"), Name: r.PathValue("account")})
+	})
+	mux.HandleFunc("GET /enzi/v0/accounts/{org}/teams/{team}/members", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		users := []map[string]any{}
+		for _, id := range *members {
+			users = append(users, map[string]any{"isAdmin": false, "member": client.ResponseAccount{ID: id}})
+		}
+		reply(w, map[string]any{"members": users})
+	})
+	mux.HandleFunc("PUT /enzi/v0/accounts/{org}/teams/{team}/memberSyncConfig", func(w http.ResponseWriter, r *http.Request) {
+		opts := client.MemberSyncOpts{}
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			t.Errorf("decoding the sync config: %s", err)
+		}
+		reply(w, opts)
+	})
+	mux.HandleFunc("PUT /enzi/v0/accounts/{org}/teams/{team}/members/{member}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		*members = append(*members, r.PathValue("member"))
+		reply(w, map[string]bool{"isAdmin": false})
+	})
+	mux.HandleFunc("DELETE /enzi/v0/accounts/{org}/teams/{team}/members/{member}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		*members = slices.DeleteFunc(*members, func(id string) bool { return id == r.PathValue("member") })
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testTeamPlan builds the plan of the acme/devs team with the user ids, the attributes left out are null.
+func testTeamPlan(t *testing.T, s resource.SchemaResponse, id string, userIDs []string) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	planType := s.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range planType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "devs")
+	values["org_id"] = tftypes.NewValue(tftypes.String, "acme")
+	values["description"] = tftypes.NewValue(tftypes.String, "")
+	if id != "" {
+		values["id"] = tftypes.NewValue(tftypes.String, id)
+	}
+	ids := make([]tftypes.Value, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, tftypes.NewValue(tftypes.String, userID))
+	}
+	values["user_ids"] = tftypes.NewValue(planType.AttributeTypes["user_ids"], ids)
+
+	return tfsdk.Plan{Schema: s.Schema, Raw: tftypes.NewValue(planType, values)}
+}
+
+func TestTeamResourceMembers(t *testing.T) {
+	ctx := context.Background()
+	members := []string{}
+	server := newTeamMembersServer(t, &members)
+
+	c, err := client.NewClient("admin", "admin", server.URL, false, server.Client())
+	if err != nil {
+		t.Fatalf("creating the client: %s", err)
+	}
+	r := NewTeamResource()
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &configureResp)
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	newIdentity := func() *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: identitySchemaResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+	}
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	// The user ids are added to the created team
+	createPlan := testTeamPlan(t, schemaResp, "", []string{"u-1", "u-2"})
+	createResp := resource.CreateResponse{State: nullState, Identity: newIdentity()}
+	r.Create(ctx, resource.CreateRequest{Plan: createPlan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+	if !slices.Equal(members, []string{"u-1", "u-2"}) {
+		t.Errorf("expected the members [u-1 u-2] after the creation, got %v", members)
+	}
+
+	// The team members are replaced with the user ids on update
+	updatePlan := testTeamPlan(t, schemaResp, "t-1", []string{"u-3"})
+	updateResp := resource.UpdateResponse{State: createResp.State, Identity: newIdentity()}
+	r.Update(ctx, resource.UpdateRequest{Plan: updatePlan, State: createResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", updateResp.Diagnostics)
+	}
+	if !slices.Equal(members, []string{"u-3"}) {
+		t.Errorf("expected the members [u-3] after the update, got %v", members)
+	}
+	// The members of a team synced with LDAP are left to the sync
+	syncPlan := testTeamPlan(t, schemaResp, "t-1", nil)
+	if diags := syncPlan.SetAttribute(ctx, path.Root("ldap_sync"), teamLDAPSyncModel{
+		SyncMode:           types.StringValue(ldapSyncModeGroup),
+		GroupDN:            types.StringValue("cn=devs,ou=groups,dc=example,dc=com"),
+		GroupMemberAttr:    types.StringNull(),
+		SearchBaseDN:       types.StringNull(),
+		SearchScopeSubtree: types.BoolNull(),
+		SearchFilter:       types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("setting the LDAP sync: %v", diags)
+	}
+	syncResp := resource.UpdateResponse{State: updateResp.State, Identity: newIdentity()}
+	r.Update(ctx, resource.UpdateRequest{Plan: syncPlan, State: updateResp.State}, &syncResp)
+	if syncResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", syncResp.Diagnostics)
+	}
+	if !slices.Equal(members, []string{"u-3"}) {
+		t.Errorf("expected the members [u-3] to be left to the sync, got %v", members)
+	}
+}
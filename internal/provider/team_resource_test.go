@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestTeamResourceDefault(t *testing.T) {
@@ -27,7 +29,37 @@ func TestTeamResourceDefault(t *testing.T) {
 				ImportStateId: "test,test",
 				ImportState:   true,
 			},
+			// ImportState testing with a slash-separated identifier
+			{
+				ResourceName:  "msr_team.test",
+				ImportStateId: "test/test",
+				ImportState:   true,
+			},
+			// ImportState testing with the MSR ID of the team
+			{
+				ResourceName:  "msr_team.test",
+				ImportStateId: "test/t-1",
+				ImportState:   true,
+			},
 			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "msr_team" "test" {
+				name = "blah"
+				org_id = "test"
+				description = "blah"
+			}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_team.test", "name", "blah"),
+					resource.TestCheckResourceAttr("msr_team.test", "description", "blah"),
+				),
+			},
+			// Replace and Read testing
 			{
 				Config: providerConfig + `
 				resource "msr_team" "test" {
@@ -35,6 +67,11 @@ func TestTeamResourceDefault(t *testing.T) {
 				org_id = "blah"
 				description = "blah"
 			}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_team.test", plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("msr_team.test", "name", "blah"),
 					resource.TestCheckResourceAttr("msr_team.test", "org_id", "blah"),
@@ -54,3 +91,62 @@ func testTeamResourceDefault() string {
 		description = "test"
 	}`
 }
+
+func TestTeamResourceLDAPSync(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only the group and search sync modes exist
+			{
+				Config:      providerConfig + testTeamResourceLDAPSync("members"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// The members of a synced team are managed by the sync
+			{
+				Config: providerConfig + `
+				resource "msr_team" "test" {
+					name     = "test"
+					org_id   = "test"
+					user_ids = ["u-1"]
+
+					ldap_sync {
+						group_dn = "cn=devs,ou=groups,dc=example,dc=com"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testTeamResourceLDAPSync("group"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_team.test", "ldap_sync.sync_mode", "group"),
+					resource.TestCheckResourceAttr("msr_team.test", "ldap_sync.group_dn", "cn=devs,ou=groups,dc=example,dc=com"),
+					resource.TestCheckResourceAttr("msr_team.test", "ldap_sync.group_member_attr", "member"),
+				),
+			},
+			// Turn the sync off
+			{
+				Config: providerConfig + testTeamResourceDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("msr_team.test", "ldap_sync.group_dn"),
+				),
+			},
+		},
+	})
+}
+
+func testTeamResourceLDAPSync(mode string) string {
+	return `
+	resource "msr_team" "test" {
+		name        = "test"
+		org_id      = "test"
+		description = "test"
+
+		ldap_sync {
+			sync_mode         = "` + mode + `"
+			group_dn          = "cn=devs,ou=groups,dc=example,dc=com"
+			group_member_attr = "member"
+		}
+	}`
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &teamsDataSource{}
+)
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &teamsDataSource{}
+}
+
+type teamsDataSource struct {
+	client client.Client
+}
+
+// teamsDataSourceModel maps the data source schema data.
+type teamsDataSourceModel struct {
+	ID             types.String    `tfsdk:"id"`
+	Org            types.String    `tfsdk:"org"`
+	NameRegex      types.String    `tfsdk:"name_regex"`
+	IncludeMembers types.Bool      `tfsdk:"include_members"`
+	Teams          []teamDataModel `tfsdk:"teams"`
+}
+
+// teamDataModel maps a single team of the organization.
+type teamDataModel struct {
+	ID           types.String          `tfsdk:"id"`
+	OrgID        types.String          `tfsdk:"org_id"`
+	Name         types.String          `tfsdk:"name"`
+	Description  types.String          `tfsdk:"description"`
+	MembersCount types.Int64           `tfsdk:"members_count"`
+	Members      []teamMemberDataModel `tfsdk:"members"`
+}
+
+// teamMemberDataModel maps a single member of a team.
+type teamMemberDataModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *teamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *teamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Teams data source. Lists the teams of an organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+			},
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the organization",
+				Required:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list the teams whose name matches the regular expression",
+				Optional:            true,
+				Validators:          []validator.String{regexValidator{}},
+			},
+			"include_members": schema.BoolAttribute{
+				MarkdownDescription: "Also retrieve the members of each team, at the cost of one request per team",
+				Optional:            true,
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "The teams of the organization",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the team",
+							Computed:            true,
+						},
+						"org_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the organization of the team",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the team",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the team",
+							Computed:            true,
+						},
+						"members_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of members of the team",
+							Computed:            true,
+						},
+						"members": schema.ListNestedAttribute{
+							MarkdownDescription: "The members of the team, when `include_members` is set",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the user",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name of the user",
+										Computed:            true,
+									},
+									"is_admin": schema.BoolAttribute{
+										MarkdownDescription: "Is the user an admin of the team",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read teams data source")
+	var data teamsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = basetypes.NewStringValue(queryID(
+		data.Org.ValueString(),
+		data.NameRegex.ValueString(),
+		data.IncludeMembers.String(),
+	))
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr teams datasource handler is in testing mode, no injestion will be run.")
+		data.Teams = []teamDataModel{}
+	} else {
+		rTeams, err := d.client.ReadTeams(ctx, data.Org.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Teams",
+				err.Error(),
+			)
+			return
+		}
+
+		var nameRegex *regexp.Regexp
+		if !data.NameRegex.IsNull() {
+			nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+		}
+
+		teams := []teamDataModel{}
+		for _, t := range rTeams {
+			if nameRegex != nil && !nameRegex.MatchString(t.Name) {
+				continue
+			}
+			team := teamDataModel{
+				ID:           basetypes.NewStringValue(t.ID),
+				OrgID:        basetypes.NewStringValue(t.OrgID),
+				Name:         basetypes.NewStringValue(t.Name),
+				Description:  basetypes.NewStringValue(t.Description),
+				MembersCount: basetypes.NewInt64Value(int64(t.MembersCount)),
+			}
+
+			if data.IncludeMembers.ValueBool() {
+				tUsers, err := d.client.GetTeamUsers(ctx, data.Org.ValueString(), t.ID)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Read Team Members",
+						err.Error(),
+					)
+					return
+				}
+				team.Members = []teamMemberDataModel{}
+				for _, m := range tUsers.Members {
+					team.Members = append(team.Members, teamMemberDataModel{
+						ID:      basetypes.NewStringValue(m.Member.ID),
+						Name:    basetypes.NewStringValue(m.Member.Name),
+						IsAdmin: basetypes.NewBoolValue(m.IsAdmin),
+					})
+				}
+			}
+
+			teams = append(teams, team)
+		}
+		data.Teams = teams
+
+		tflog.Trace(ctx, fmt.Sprintf("read %d teams in teams data source", len(teams)))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading teams data source", map[string]any{"success": true})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTeamsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The name regex must compile
+			{
+				Config: providerConfig + `
+				data "msr_teams" "test" {
+					org        = "example"
+					name_regex = "[dev"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid regular expression"),
+			},
+			{
+				Config: providerConfig + `
+				data "msr_teams" "test" {
+					org             = "example"
+					name_regex      = "^dev"
+					include_members = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_teams.test", "teams.#", "0"),
+					// Verify the id is derived from the query
+					resource.TestCheckResourceAttr("data.msr_teams.test", "id", queryID("example", "^dev", "true")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &userDataSource{}
+)
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client client.Client
+}
+
+// userDataSourceModel maps the data source schema data.
+type userDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	FullName   types.String `tfsdk:"full_name"`
+	IsActive   types.Bool   `tfsdk:"is_active"`
+	IsAdmin    types.Bool   `tfsdk:"is_admin"`
+	IsImported types.Bool   `tfsdk:"is_imported"`
+	OnDemand   types.Bool   `tfsdk:"on_demand"`
+	OtpEnabled types.Bool   `tfsdk:"otp_enabled"`
+	TeamsCount types.Int64  `tfsdk:"teams_count"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User data source. Looks up a user by name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user",
+				Required:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the user",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Is the user active",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Is the user admin",
+				Computed:            true,
+			},
+			"is_imported": schema.BoolAttribute{
+				MarkdownDescription: "Is the user imported from LDAP",
+				Computed:            true,
+			},
+			"on_demand": schema.BoolAttribute{
+				MarkdownDescription: "Is the user on demand",
+				Computed:            true,
+			},
+			"otp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Is `otp_enabled` for the user",
+				Computed:            true,
+			},
+			"teams_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of teams the user is a member of",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read user data source")
+	var data userDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.TestMode {
+		resp.Diagnostics.AddWarning("testing mode warning", "msr user datasource handler is in testing mode, no injestion will be run.")
+		data.fromAccount(client.ResponseAccount{ID: TestingVersion, Name: data.Name.ValueString(), IsActive: true})
+	} else {
+		rAcc, err := d.client.ReadAccount(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read User",
+				err.Error(),
+			)
+			return
+		}
+		if rAcc.IsOrg {
+			resp.Diagnostics.AddError(
+				"Unable to Read User",
+				fmt.Sprintf("account `%s` is an organization", rAcc.Name),
+			)
+			return
+		}
+		data.fromAccount(rAcc)
+
+		tflog.Trace(ctx, fmt.Sprintf("read in user data source `%s`", rAcc.ID))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Finished reading user data source", map[string]any{"success": true})
+}
+
+// fromAccount fills the model from the user account.
+func (m *userDataSourceModel) fromAccount(acc client.ResponseAccount) {
+	m.ID = types.StringValue(acc.ID)
+	m.Name = types.StringValue(acc.Name)
+	m.FullName = types.StringValue(acc.FullName)
+	m.IsActive = types.BoolValue(acc.IsActive)
+	m.IsAdmin = types.BoolValue(acc.IsAdmin)
+	m.IsImported = types.BoolValue(acc.IsImported)
+	m.OnDemand = types.BoolValue(acc.OnDemand)
+	m.OtpEnabled = types.BoolValue(acc.OtpEnabled)
+	m.TeamsCount = types.Int64Value(int64(acc.TeamsCount))
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "msr_user" "test" {
+					name = "someone"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msr_user.test", "name", "someone"),
+					resource.TestCheckResourceAttr("data.msr_user.test", "is_admin", "false"),
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.msr_user.test", "id", TestingVersion),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUserListResource(t *testing.T) {
+	ctx := context.Background()
+	server := newTestMSRServer(t)
+
+	// Organizations aren't listed
+	results := testListResults(t, server, NewUserListResource(), NewUserResource(), nil, true, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(results))
+	}
+
+	var identity UserResourceIdentityModel
+	if diags := results[0].Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("reading the identity: %v", diags)
+	}
+	if identity.Name.ValueString() != "alice" {
+		t.Errorf("expected the alice identity, got %s", identity.Name)
+	}
+
+	var model UserResourceModel
+	if diags := results[0].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading the resource: %v", diags)
+	}
+	if model.Id.ValueString() != "u-1" || model.FullName.ValueString() != "Alice" || !model.Password.IsNull() {
+		t.Errorf("unexpected resource %+v", model)
+	}
+
+	results = testListResults(t, server, NewUserListResource(), NewUserResource(), map[string]string{"name_regex": "^adm"}, false, 0)
+	if len(results) != 1 || results[0].DisplayName != "admin" {
+		t.Errorf("expected only admin, got %d results", len(results))
+	}
+}
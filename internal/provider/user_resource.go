@@ -5,11 +5,16 @@ import (
 	"fmt"
 
 	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,14 +24,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &UserResource{}
+var (
+	_ resource.Resource                 = &UserResource{}
+	_ resource.ResourceWithModifyPlan   = &UserResource{}
+	_ resource.ResourceWithImportState  = &UserResource{}
+	_ resource.ResourceWithIdentity     = &UserResource{}
+	_ resource.ResourceWithUpgradeState = &UserResource{}
+)
 
 type UserResourceModel struct {
 	Name     types.String `tfsdk:"name"`
 	Password types.String `tfsdk:"password"`
-	FullName types.String `tfsdk:"full_name"`
-	IsAdmin  types.Bool   `tfsdk:"is_admin"`
-	Id       types.String `tfsdk:"id"`
+	// PasswordWO is write-only, it's only present in the config and never stored
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	FullName          types.String `tfsdk:"full_name"`
+	IsAdmin           types.Bool   `tfsdk:"is_admin"`
+	IsActive          types.Bool   `tfsdk:"is_active"`
+	// Computed attributes, MSR manages them
+	IsImported types.Bool  `tfsdk:"is_imported"`
+	OtpEnabled types.Bool  `tfsdk:"otp_enabled"`
+	TeamsCount types.Int64 `tfsdk:"teams_count"`
+	// Provider side attributes, they aren't sent to MSR
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	OnDestroy          types.String   `tfsdk:"on_destroy"`
+	Id                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// UserResourceIdentityModel identifies a user by its name.
+type UserResourceIdentityModel struct {
+	Name types.String `tfsdk:"name"`
 }
 
 type UserResource struct {
@@ -43,8 +71,13 @@ func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -54,17 +87,45 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the user",
+				MarkdownDescription: "The name of the user. MSR can't rename users, changing it replaces the user",
 				Required:            true,
 				Validators:          []validator.String{stringvalidator.LengthBetween(3, 32)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the user",
+				MarkdownDescription: "The password of the user. Changing it after creation sets the new password in MSR. " +
+					"A random password is generated when neither `password` nor `password_wo` are set. " +
+					"The password is stored in the state, use `password_wo` to keep it out",
+				Optional:  true,
+				Sensitive: true,
+				Computed:  true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 16),
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The write-only password of the user, it is never stored in the state. " +
+					"It's only sent to MSR on creation and when `password_wo_version` changes. " +
+					"Use the `msr_generated_password` ephemeral resource to generate it. Requires Terraform 1.11 or later",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 16),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `password_wo`, change it to set a new password in MSR",
 				Optional:            true,
-				Sensitive:           true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
-				Validators:          []validator.String{stringvalidator.LengthBetween(8, 16)},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"full_name": schema.StringAttribute{
 				MarkdownDescription: "The full name of the user",
@@ -78,11 +139,103 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:             booldefault.StaticBool(false),
 				Optional:            true,
 			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Is the user active. Inactive users can't log in",
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				Optional:            true,
+			},
+			"is_imported": schema.BoolAttribute{
+				MarkdownDescription: "Was the user imported from an identity provider",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"otp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Has the user enabled two factor authentication",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"teams_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of teams the user is a member of",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute(),
+			"on_destroy":          onDestroyAttribute(),
 		},
 		MarkdownDescription: "User resource",
 	}
 }
 
+// userResourceModelV0 is the user state before the write-only password, the account status and the provider side attributes.
+type userResourceModelV0 struct {
+	Name     types.String `tfsdk:"name"`
+	Password types.String `tfsdk:"password"`
+	FullName types.String `tfsdk:"full_name"`
+	IsAdmin  types.Bool   `tfsdk:"is_admin"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func (r *UserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 adds password_wo, password_wo_version, is_active, the computed account attributes,
+		// deletion_protection and on_destroy
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":        schema.StringAttribute{Computed: true},
+					"name":      schema.StringAttribute{Required: true},
+					"password":  schema.StringAttribute{Optional: true, Computed: true, Sensitive: true},
+					"full_name": schema.StringAttribute{Optional: true, Computed: true},
+					"is_admin":  schema.BoolAttribute{Optional: true, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior userResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The computed account attributes are unknown until the next read
+				resp.Diagnostics.Append(resp.State.Set(ctx, UserResourceModel{
+					Name:               prior.Name,
+					Password:           prior.Password,
+					PasswordWO:         types.StringNull(),
+					PasswordWOVersion:  types.Int64Null(),
+					FullName:           prior.FullName,
+					IsAdmin:            prior.IsAdmin,
+					IsActive:           types.BoolValue(true),
+					IsImported:         types.BoolNull(),
+					OtpEnabled:         types.BoolNull(),
+					TeamsCount:         types.Int64Null(),
+					DeletionProtection: types.BoolValue(false),
+					OnDestroy:          types.StringValue(onDestroyDelete),
+					Timeouts:           nullTimeouts(),
+					Id:                 prior.Id,
+				})...)
+			},
+		},
+	}
+}
+
+func (r *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the user",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -104,13 +257,35 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *UserResourceModel
+	var passwordWO types.String
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, the write-only password is only in the config
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	pass := data.Password.ValueString()
-	if pass == "" {
-		pass = client.GeneratePass()
+	switch {
+	case !passwordWO.IsNull():
+		pass = passwordWO.ValueString()
+		data.Password = types.StringNull()
+	case data.Password.IsUnknown() || pass == "":
+		generated, err := client.GeneratePassword(client.DefaultPasswordPolicy)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		pass = generated
 		data.Password = basetypes.NewStringValue(pass)
 	}
 
@@ -119,6 +294,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Password:   pass,
 		FullName:   data.FullName.ValueString(),
 		IsAdmin:    data.IsAdmin.ValueBool(),
+		IsActive:   data.IsActive.ValueBool(),
 		IsOrg:      false,
 		SearchLDAP: false,
 	}
@@ -130,6 +306,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr user resource handler is in testing mode, no creation will be run.")
 		data.Id = basetypes.NewStringValue(TestingVersion)
+		data.fromTestingAccount()
 	} else {
 		rAcc, err := r.client.CreateAccount(ctx, acc)
 		if err != nil {
@@ -143,10 +320,11 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		tflog.Trace(ctx, fmt.Sprintf("created User resource `%s`", data.Name.ValueString()))
 
 		data.Id = basetypes.NewStringValue(rAcc.ID)
-		data.Password = basetypes.NewStringValue(pass)
+		data.fromAccount(rAcc)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -160,9 +338,17 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr user resource handler is in testing mode, no read will be run.")
 		data.Id = types.StringValue(TestingVersion)
+		data.fromTestingAccount()
 	} else {
 		rAcc, err := r.client.ReadAccount(ctx, data.Name.ValueString())
 		if err != nil {
@@ -171,37 +357,71 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 		data.Id = types.StringValue(rAcc.ID)
 		data.Name = types.StringValue(rAcc.Name)
-		data.FullName = types.StringValue(rAcc.FullName)
-		data.IsAdmin = types.BoolValue(rAcc.IsAdmin)
+		data.fromAccount(rAcc)
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Preparing to update user resource")
 
-	var data *UserResourceModel
+	var data, state *UserResourceModel
+	var passwordWO types.String
 
-	// Read Terraform prior state data into the model
+	// Read Terraform plan and prior state data into the models, the write-only password is only in the config
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr user resource handler is in testing mode, no update will be run.")
 		data.Id = types.StringValue(TestingVersion)
+		data.fromTestingAccount()
 	} else {
+		if pass := data.Password.ValueString(); pass != "" && pass != state.Password.ValueString() {
+			change := client.ChangePassword{
+				OldPassword: state.Password.ValueString(),
+				NewPassword: pass,
+			}
+			if _, err := r.client.ChangeAccountPassword(ctx, data.Id.ValueString(), change); err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
+			tflog.Trace(ctx, fmt.Sprintf("changed User resource `%s` password", data.Name.ValueString()))
+		}
+
+		// The previous write-only password isn't known, MSR only accepts it from admins
+		if !passwordWO.IsNull() && !data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+			change := client.ChangePassword{
+				NewPassword: passwordWO.ValueString(),
+			}
+			if _, err := r.client.ChangeAccountPassword(ctx, data.Id.ValueString(), change); err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
+			tflog.Trace(ctx, fmt.Sprintf("changed User resource `%s` write-only password", data.Name.ValueString()))
+		}
+
 		user := client.UpdateAccount{
 			FullName: data.FullName.ValueString(),
+			IsActive: data.IsActive.ValueBool(),
 			IsAdmin:  data.IsAdmin.ValueBool(),
 		}
 		rAcc, err := r.client.UpdateAccount(ctx, data.Id.ValueString(), user)
-		tflog.Debug(ctx, fmt.Sprintf("The retuerned 'user' %+v", rAcc))
-
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", err.Error())
 			return
@@ -210,21 +430,79 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		// Overwrite user with refreshed state
 		data.Id = types.StringValue(rAcc.ID)
 		data.Name = types.StringValue(rAcc.Name)
-		data.FullName = types.StringValue(rAcc.FullName)
-		data.IsAdmin = types.BoolValue(rAcc.IsAdmin)
+		data.fromAccount(rAcc)
 	}
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	tflog.Debug(ctx, "Updated 'user' resource", map[string]any{"success": true})
 }
 
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateName, planName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planName)...)
+		if !stateName.Equal(planName) {
+			resp.Diagnostics.AddWarning(
+				"User replacement",
+				fmt.Sprintf("MSR can't rename users. User %s will be deleted along with its team memberships and its permissions on repos, and %s created.",
+					stateName.ValueString(), planName.ValueString()),
+			)
+		}
+	}
+
+	var passwordWO, password, priorPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A write-only password replaces the one stored in the state
+	if !passwordWO.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+		return
+	}
+
+	// MSR never returns the password, an imported user keeps none in the state until one is configured
+	if !req.State.Raw.IsNull() && password.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password"), &priorPassword)...)
+		if priorPassword.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+		}
+	}
+}
+
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *UserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteUser, diags := checkDestroy(ctx, "user", data.Name.ValueString(), data.DeletionProtection, data.OnDestroy)
+	resp.Diagnostics.Append(diags...)
+	if !deleteUser {
+		return
+	}
+
 	if r.client.TestMode {
 		resp.Diagnostics.AddWarning("testing mode warning", "msr user resource handler is in testing mode, no deletion will be run.")
 	} else if err := r.client.DeleteAccount(ctx, data.Id.ValueString()); err != nil {
@@ -236,5 +514,28 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+	resp.Diagnostics.Append(importDestroyDefaults(ctx, &resp.State)...)
+}
+
+// fromAccount sets the MSR managed values of the model from an account.
+func (m *UserResourceModel) fromAccount(acc client.ResponseAccount) {
+	m.FullName = types.StringValue(acc.FullName)
+	m.IsAdmin = types.BoolValue(acc.IsAdmin)
+	m.IsActive = types.BoolValue(acc.IsActive)
+	m.IsImported = types.BoolValue(acc.IsImported)
+	m.OtpEnabled = types.BoolValue(acc.OtpEnabled)
+	m.TeamsCount = types.Int64Value(int64(acc.TeamsCount))
+}
+
+// fromTestingAccount sets the computed values of the model when no account is read from MSR.
+func (m *UserResourceModel) fromTestingAccount() {
+	m.IsImported = types.BoolValue(false)
+	m.OtpEnabled = types.BoolValue(false)
+	m.TeamsCount = types.Int64Value(0)
+}
+
+// identity returns the identity of the user.
+func (m *UserResourceModel) identity() UserResourceIdentityModel {
+	return UserResourceIdentityModel{Name: m.Name}
 }
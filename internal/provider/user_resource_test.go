@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestUserResourceDefault(t *testing.T) {
@@ -19,6 +21,10 @@ func TestUserResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("msr_user.test", "password", TestingVersion+TestingVersion),
 					resource.TestCheckResourceAttr("msr_user.test", "full_name", TestingVersion),
 					resource.TestCheckResourceAttr("msr_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("msr_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("msr_user.test", "is_imported", "false"),
+					resource.TestCheckResourceAttr("msr_user.test", "otp_enabled", "false"),
+					resource.TestCheckResourceAttr("msr_user.test", "teams_count", "0"),
 					resource.TestCheckResourceAttrSet("msr_user.test", "id"),
 				),
 			},
@@ -27,7 +33,7 @@ func TestUserResourceDefault(t *testing.T) {
 				ResourceName: "msr_user.test",
 				ImportState:  true,
 			},
-			// Update and Read testing
+			// Replace and Read testing
 			{
 				Config: providerConfig + `
 				resource "msr_user" "test" {
@@ -35,6 +41,11 @@ func TestUserResourceDefault(t *testing.T) {
 				password = "blahblah"
 				full_name = "blah"
 			}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_user.test", plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("msr_user.test", "name", "blah"),
 					resource.TestCheckResourceAttr("msr_user.test", "password", "blahblah"),
@@ -43,6 +54,27 @@ func TestUserResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttrSet("msr_user.test", "id"),
 				),
 			},
+			// Deactivate, promote and change the password
+			{
+				Config: providerConfig + `
+				resource "msr_user" "test" {
+				name = "blah"
+				password = "changedpass"
+				is_active = false
+				is_admin = true
+			}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("msr_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msr_user.test", "password", "changedpass"),
+					resource.TestCheckResourceAttr("msr_user.test", "full_name", ""),
+					resource.TestCheckResourceAttr("msr_user.test", "is_active", "false"),
+					resource.TestCheckResourceAttr("msr_user.test", "is_admin", "true"),
+				),
+			},
 			// Delete is called implicitly
 		},
 	})
@@ -56,3 +88,22 @@ func testUserResourceDefault() string {
 		full_name = "test"
 	}`
 }
+
+func TestUserResourceGeneratedPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A password is generated when none is configured
+			{
+				Config: providerConfig + `
+				resource "msr_user" "test" {
+					name = "test"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("msr_user.test", "password", regexp.MustCompile(`^.{16}$`)),
+				),
+			},
+		},
+	})
+}
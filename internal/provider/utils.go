@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// queryID builds a deterministic data source identifier from the values of its query arguments.
+func queryID(args ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(args, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// optionalString returns the value read from MSR, keeping the attribute null
+// when it was not configured and MSR reports the zero value.
+func optionalString(prior types.String, remote string) types.String {
+	if prior.IsNull() && remote == "" {
+		return prior
+	}
+	return types.StringValue(remote)
+}
+
+// optionalBool returns the value read from MSR, keeping the attribute null
+// when it was not configured and MSR reports the zero value.
+func optionalBool(prior types.Bool, remote bool) types.Bool {
+	if prior.IsNull() && !remote {
+		return prior
+	}
+	return types.BoolValue(remote)
+}
+
+// secretString keeps the prior value of a secret MSR does not return.
+func secretString(prior types.String, remote string) types.String {
+	if remote == "" {
+		return prior
+	}
+	return types.StringValue(remote)
+}
+
+// On destroy modes.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyAbandon = "abandon"
+)
+
+// deletionProtectionAttribute is the schema of the attribute blocking the deletion of a resource.
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Refuse to delete the object while set. It must be set to false in a prior apply for the object to be destroyed",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// onDestroyAttribute is the schema of the attribute choosing what happens to the object on destroy.
+func onDestroyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "What happens to the object on destroy: `delete` deletes it from MSR, `abandon` only removes it from the Terraform state",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(onDestroyDelete),
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyDelete, onDestroyAbandon),
+		},
+	}
+}
+
+// checkDestroy reports whether the object may be deleted from MSR.
+// An error is returned while the object is protected, and abandoned objects are only removed from the state.
+func checkDestroy(ctx context.Context, kind string, name string, deletionProtection types.Bool, onDestroy types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if deletionProtection.ValueBool() {
+		diags.AddError(
+			"Deletion protection",
+			fmt.Sprintf("The %s %s has deletion_protection set. Set it to false and apply before destroying it.", kind, name),
+		)
+		return false, diags
+	}
+
+	if onDestroy.ValueString() == onDestroyAbandon {
+		tflog.Debug(ctx, fmt.Sprintf("Abandoning %s %s, it is only removed from the state", kind, name))
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// defaultTimeout bounds the resource operations left out of the timeouts block.
+const defaultTimeout = 20 * time.Minute
+
+// withTimeout returns the context of a resource operation, cancelled once the timeout
+// set for the operation in the timeouts block, or the default one, is over.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	d, diags := timeout(ctx, defaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, diags
+}
+
+// nullTimeouts is the timeouts block, unset, of the resources with timeouts for all the operations.
+// It fills the models that aren't read from a plan or a state.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
+
+// importDestroyDefaults sets the defaults of the destroy attributes on import,
+// MSR doesn't know about them.
+func importDestroyDefaults(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("on_destroy"), onDestroyDelete)...)
+	return diags
+}
+
+// splitImportID splits a composite import identifier on "/".
+// The "," separator of the earlier releases is still accepted.
+func splitImportID(id string) []string {
+	if !strings.Contains(id, "/") {
+		return strings.Split(id, ",")
+	}
+	return strings.Split(id, "/")
+}
+
+// importIDError reports an import identifier not matching the expected format.
+func importIDError(format string, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(
+		"Unexpected Import Identifier",
+		fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, id),
+	)
+	return diags
+}
+
+// importRepo resolves the repository an import identifier starts with, followed by extra parts.
+// The repository is given either as namespace/repo_name or by its MSR ID, which is looked up in the repository list.
+func importRepo(ctx context.Context, c client.Client, id string, extra int, format string) (string, string, []string, diag.Diagnostics) {
+	parts := splitImportID(id)
+	for _, part := range parts {
+		if part == "" {
+			return "", "", nil, importIDError(format, id)
+		}
+	}
+
+	switch len(parts) {
+	case extra + 2:
+		return parts[0], parts[1], parts[2:], nil
+	case extra + 1:
+		if c.TestMode {
+			return TestingVersion, TestingVersion, parts[1:], nil
+		}
+		repos, err := c.ReadRepos(ctx, "")
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Client Error", err.Error())
+			return "", "", nil, diags
+		}
+		for _, repo := range repos {
+			if repo.ID == parts[0] {
+				return repo.Namespace, repo.Name, parts[1:], nil
+			}
+		}
+		var diags diag.Diagnostics
+		diags.AddError("Repository not found", fmt.Sprintf("No repository has the ID %q", parts[0]))
+		return "", "", nil, diags
+	default:
+		return "", "", nil, importIDError(format, id)
+	}
+}
+
+// newListResult builds the result of a listed object from its identity and resource model.
+// The resource is only set when Terraform asks for it.
+func newListResult(ctx context.Context, req list.ListRequest, displayName string, identity any, model any) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+	result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+	if req.IncludeResource {
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	}
+	return result
+}
+
+// listNameRegex compiles the optional name filter of a list block, nil when it isn't set.
+// The expression is checked by regexValidator beforehand.
+func listNameRegex(nameRegex types.String) *regexp.Regexp {
+	if nameRegex.ValueString() == "" {
+		return nil
+	}
+	return regexp.MustCompile(nameRegex.ValueString())
+}
+
+// listLimitReached reports whether Terraform already received all the results it expects.
+func listLimitReached(req list.ListRequest, count int64) bool {
+	return req.Limit > 0 && count >= req.Limit
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = cronExpressionValidator{}
+	_ validator.String = durationValidator{}
+	_ validator.String = regexValidator{}
+	_ validator.String = httpsURLValidator{}
+	_ validator.String = pemCertificatesValidator{}
+	_ validator.String = samlMetadataValidator{}
+)
+
+// cronExpressionValidator validates that a string is a cron expression accepted by the MSR job scheduler.
+type cronExpressionValidator struct{}
+
+func (v cronExpressionValidator) Description(ctx context.Context) string {
+	return "value must be a cron expression with 5 or 6 fields (seconds optional) or a predefined schedule such as `@daily`"
+}
+
+func (v cronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCronExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron expression",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// durationValidator validates that a string is a Go duration such as `30m` or `1h30m`.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as `30m` or `1h30m`"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && d <= 0 {
+		err = fmt.Errorf("duration %s is not positive", d)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// regexValidator validates that a string is a regular expression in the Go RE2 syntax.
+type regexValidator struct{}
+
+func (v regexValidator) Description(ctx context.Context) string {
+	return "value must be a regular expression in the RE2 syntax"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// httpsURLValidator validates that a string is an absolute https URL.
+type httpsURLValidator struct{}
+
+func (v httpsURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute URL with the https scheme"
+}
+
+func (v httpsURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v httpsURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateHTTPSURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// pemCertificatesValidator validates that a string holds one or more PEM encoded x509 certificates.
+type pemCertificatesValidator struct{}
+
+func (v pemCertificatesValidator) Description(ctx context.Context) string {
+	return "value must hold one or more PEM encoded x509 certificates"
+}
+
+func (v pemCertificatesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pemCertificatesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if err := validatePEMCertificates(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid certificates",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// samlMetadataValidator validates that a string is a SAML metadata XML document.
+type samlMetadataValidator struct{}
+
+func (v samlMetadataValidator) Description(ctx context.Context) string {
+	return "value must be a SAML metadata XML document with an EntityDescriptor root element"
+}
+
+func (v samlMetadataValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v samlMetadataValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateSAMLMetadata(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SAML metadata",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// validateHTTPSURL checks that the URL is absolute, uses https and has a host.
+func validateHTTPSURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("scheme %q is not https", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("no host in %q", raw)
+	}
+	return nil
+}
+
+// validatePEMCertificates checks that every PEM block is a parsable certificate.
+func validatePEMCertificates(certs string) error {
+	rest := []byte(certs)
+	found := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return err
+		}
+		found++
+	}
+	if found == 0 {
+		return fmt.Errorf("no PEM encoded certificate found")
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return fmt.Errorf("unexpected data after the certificates")
+	}
+	return nil
+}
+
+// validateSAMLMetadata checks that the document is well formed XML rooted at an EntityDescriptor.
+func validateSAMLMetadata(metadata string) error {
+	doc := struct {
+		XMLName xml.Name
+	}{}
+	if err := xml.Unmarshal([]byte(metadata), &doc); err != nil {
+		return err
+	}
+	if doc.XMLName.Local != "EntityDescriptor" {
+		return fmt.Errorf("unexpected root element %q", doc.XMLName.Local)
+	}
+	return nil
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronMonths = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	cronWeekdays = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+	cronFields = []cronField{
+		{name: "second", min: 0, max: 59},
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: cronMonths},
+		{name: "day of week", min: 0, max: 6, names: cronWeekdays},
+	}
+	cronDescriptors = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+)
+
+// validateCronExpression checks a cron expression the same way the MSR scheduler parses it.
+func validateCronExpression(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		if strings.HasPrefix(expr, "@every ") {
+			d, err := time.ParseDuration(strings.TrimPrefix(expr, "@every "))
+			if err != nil {
+				return err
+			}
+			if d <= 0 {
+				return fmt.Errorf("@every duration %s is not positive", d)
+			}
+			return nil
+		}
+		for _, d := range cronDescriptors {
+			if expr == d {
+				return nil
+			}
+		}
+		return fmt.Errorf("unrecognized descriptor %q", expr)
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		// The seconds field is optional and defaults to 0.
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return fmt.Errorf("expected 5 or 6 fields, found %d in %q", len(fields), expr)
+	}
+
+	for i, f := range fields {
+		if err := cronFields[i].validate(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cf cronField) validate(field string) error {
+	for _, term := range strings.Split(field, ",") {
+		if err := cf.validateTerm(term); err != nil {
+			return fmt.Errorf("%s field %q: %w", cf.name, field, err)
+		}
+	}
+	return nil
+}
+
+func (cf cronField) validateTerm(term string) error {
+	rangePart, step, hasStep := strings.Cut(term, "/")
+	if hasStep {
+		s, err := strconv.Atoi(step)
+		if err != nil || s <= 0 {
+			return fmt.Errorf("invalid step %q", step)
+		}
+	}
+
+	if rangePart == "*" || rangePart == "?" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(rangePart, "-")
+	start, err := cf.value(low)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	end, err := cf.value(high)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("range start %d is after range end %d", start, end)
+	}
+	return nil
+}
+
+func (cf cronField) value(s string) (int, error) {
+	if v, ok := cf.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < cf.min || v > cf.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, cf.min, cf.max)
+	}
+	return v, nil
+}
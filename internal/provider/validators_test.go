@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestValidateCronExpression(t *testing.T) {
+	valid := []string{
+		"0 0 1 * * 6",
+		"0 1 * * 6",
+		"*/30 * * * * *",
+		"0 0 22 * * mon-fri",
+		"0 30 2 1,15 JAN-JUN ?",
+		"@daily",
+		"@every 12h",
+	}
+	for _, expr := range valid {
+		if err := validateCronExpression(expr); err != nil {
+			t.Errorf("expected %q to be valid, got (%v)", expr, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"* * *",
+		"0 0 24 * * *",
+		"0 60 * * * *",
+		"0 0 0 0 * *",
+		"0 0 0 * 13 *",
+		"0 0 0 * * 7",
+		"0 0 5-2 * * *",
+		"0 */0 * * * *",
+		"0 0 0 * * funday",
+		"@fortnightly",
+		"@every soon",
+	}
+	for _, expr := range invalid {
+		if err := validateCronExpression(expr); err == nil {
+			t.Errorf("expected %q to be invalid", expr)
+		}
+	}
+}
+
+func TestValidateHTTPSURL(t *testing.T) {
+	for _, u := range []string{"https://idp.example.com/metadata", "https://idp.example.com:8443"} {
+		if err := validateHTTPSURL(u); err != nil {
+			t.Errorf("expected %q to be valid, got (%v)", u, err)
+		}
+	}
+	for _, u := range []string{"", "http://idp.example.com/metadata", "https:///metadata", "idp.example.com", "https://%zz"} {
+		if err := validateHTTPSURL(u); err == nil {
+			t.Errorf("expected %q to be invalid", u)
+		}
+	}
+}
+
+func TestValidatePEMCertificates(t *testing.T) {
+	cert := testPEMCertificate(t)
+	for _, certs := range []string{cert, cert + "\n" + cert} {
+		if err := validatePEMCertificates(certs); err != nil {
+			t.Errorf("expected certificates to be valid, got (%v)", err)
+		}
+	}
+
+	key := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}))
+	broken := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a certificate")}))
+	for _, certs := range []string{"not a certificate", key, broken, cert + "trailing"} {
+		if err := validatePEMCertificates(certs); err == nil {
+			t.Errorf("expected %q to be invalid", certs)
+		}
+	}
+}
+
+func TestValidateSAMLMetadata(t *testing.T) {
+	valid := `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com"></md:EntityDescriptor>`
+	if err := validateSAMLMetadata(valid); err != nil {
+		t.Errorf("expected metadata to be valid, got (%v)", err)
+	}
+	for _, metadata := range []string{"", "<EntityDescriptor>", "<Metadata></Metadata>"} {
+		if err := validateSAMLMetadata(metadata); err == nil {
+			t.Errorf("expected %q to be invalid", metadata)
+		}
+	}
+}
+
+// testPEMCertificate returns a PEM encoded self signed certificate.
+func testPEMCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -3,10 +3,15 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/Mirantis/terraform-provider-msr/internal/client"
+	"github.com/Mirantis/terraform-provider-msr/internal/export"
 	"github.com/Mirantis/terraform-provider-msr/internal/provider"
 )
 
@@ -28,6 +33,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		runExport(os.Args[2:])
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -44,3 +54,42 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runExport writes the configuration of an MSR instance, connecting with the settings of the provider.
+func runExport(args []string) {
+	var host, username, password, namespaces, outputDir string
+	var unsafeSSLClient bool
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&host, "host", "", "the host url of the MSR instance")
+	flags.StringVar(&username, "username", "", "the username to login in the MSR instance")
+	flags.StringVar(&password, "password", os.Getenv("MSR_PASSWORD"), "the password to login in the MSR instance, defaults to the MSR_PASSWORD environment variable")
+	flags.BoolVar(&unsafeSSLClient, "unsafe_ssl_client", false, "use of unsafe SSL client")
+	flags.StringVar(&namespaces, "namespaces", "", "comma separated orgs and users to export, all the orgs are exported when empty")
+	flags.StringVar(&outputDir, "output_dir", ".", "the directory the .tf files are written to")
+	_ = flags.Parse(args)
+
+	var c client.Client
+	var err error
+	if unsafeSSLClient {
+		c, err = client.NewUnsafeSSLClient(host, username, password, false)
+	} else {
+		c, err = client.NewDefaultClient(host, username, password, false)
+	}
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	opts := export.Options{OutputDir: outputDir}
+	if namespaces != "" {
+		opts.Namespaces = strings.Split(namespaces, ",")
+	}
+
+	files, err := export.Run(context.Background(), c, opts)
+	for _, file := range files {
+		fmt.Println(file)
+	}
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}